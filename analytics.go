@@ -0,0 +1,235 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An AnalyticsCalculation is a statistic of returns calculated by the
+// analytics functions. Calculations taking parameters may be given as e.g.
+// AnalyticsCalculation("STDDEV(annualized=True)").
+type AnalyticsCalculation string
+
+// Statistics of returns calculated by the analytics functions.
+const (
+	AnalyticsMin              AnalyticsCalculation = "MIN"
+	AnalyticsMax              AnalyticsCalculation = "MAX"
+	AnalyticsMean             AnalyticsCalculation = "MEAN"
+	AnalyticsMedian           AnalyticsCalculation = "MEDIAN"
+	AnalyticsCumulativeReturn AnalyticsCalculation = "CUMULATIVE_RETURN"
+	AnalyticsVariance         AnalyticsCalculation = "VARIANCE"
+	AnalyticsStdDev           AnalyticsCalculation = "STDDEV"
+	AnalyticsMaxDrawdown      AnalyticsCalculation = "MAX_DRAWDOWN"
+	AnalyticsHistogram        AnalyticsCalculation = "HISTOGRAM"
+	AnalyticsAutocorrelation  AnalyticsCalculation = "AUTOCORRELATION"
+	AnalyticsCovariance       AnalyticsCalculation = "COVARIANCE"
+	AnalyticsCorrelation      AnalyticsCalculation = "CORRELATION"
+)
+
+// An AnalyticsRequest is a request for statistics of the returns of symbols.
+//
+// See: https://www.alphavantage.co/documentation/#analytics-fixed-window
+type AnalyticsRequest struct {
+	Symbols []string
+
+	// From and To bound the dates of the returns. The full history is used
+	// when From is zero, and the latest data when To is zero.
+	From time.Time
+	To   time.Time
+
+	Interval     Interval
+	OHLC         SeriesType // Price series returns are calculated from.
+	Calculations []AnalyticsCalculation
+}
+
+func (req AnalyticsRequest) values(function string) url.Values {
+	calculations := make([]string, len(req.Calculations))
+	for i, calculation := range req.Calculations {
+		calculations[i] = string(calculation)
+	}
+	query := url.Values{
+		"function":     []string{function},
+		"SYMBOLS":      []string{strings.Join(req.Symbols, ",")},
+		"INTERVAL":     []string{string(req.Interval)},
+		"CALCULATIONS": []string{strings.Join(calculations, ",")},
+	}
+	switch {
+	case req.From.IsZero():
+		query.Set("RANGE", "full")
+	case req.To.IsZero():
+		query.Set("RANGE", req.From.Format("2006-01-02"))
+	default:
+		query["RANGE"] = []string{req.From.Format("2006-01-02"), req.To.Format("2006-01-02")}
+	}
+	setString(query, "OHLC", string(req.OHLC))
+	return query
+}
+
+// AnalyticsMetaData describes the data statistics were calculated from.
+type AnalyticsMetaData struct {
+	Symbols  string   `json:"symbols"` // Comma-separated.
+	MinDate  NullDate `json:"min_dt"`
+	MaxDate  NullDate `json:"max_dt"`
+	OHLC     string   `json:"ohlc"`
+	Interval string   `json:"interval"`
+}
+
+// An AnalyticsResult is the statistics of the returns of symbols, by
+// calculation. Use the Values, Matrix and Window methods to decode a
+// calculation.
+type AnalyticsResult struct {
+	MetaData     AnalyticsMetaData
+	Calculations map[string]json.RawMessage
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *AnalyticsResult) UnmarshalJSON(b []byte) error {
+	var v struct {
+		MetaData AnalyticsMetaData `json:"meta_data"`
+		Payload  struct {
+			ReturnsCalculations map[string]json.RawMessage `json:"RETURNS_CALCULATIONS"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.MetaData = v.MetaData
+	r.Calculations = v.Payload.ReturnsCalculations
+	return nil
+}
+
+// calculation returns the result of a calculation, ignoring case.
+func (r AnalyticsResult) calculation(calculation AnalyticsCalculation) (json.RawMessage, error) {
+	for name, b := range r.Calculations {
+		if strings.EqualFold(name, string(calculation)) {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("alphavantage: no %s calculation in analytics result", calculation)
+}
+
+// Values returns a fixed window calculation with a single value per symbol,
+// such as AnalyticsMean, by symbol.
+func (r AnalyticsResult) Values(calculation AnalyticsCalculation) (map[string]float64, error) {
+	b, err := r.calculation(calculation)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]float64
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// An AnalyticsMatrix is a symmetric matrix of statistics between pairs of
+// symbols, such as their correlation.
+type AnalyticsMatrix struct {
+	Index  []string    // Symbols of the rows and columns.
+	Values [][]float64 // Lower triangle, i.e. Values[i] has i+1 values.
+}
+
+// At returns the value for the symbols at indices i and j.
+func (m AnalyticsMatrix) At(i, j int) float64 {
+	if j > i {
+		i, j = j, i
+	}
+	return m.Values[i][j]
+}
+
+// Matrix returns a fixed window calculation between pairs of symbols, i.e.
+// AnalyticsCovariance or AnalyticsCorrelation.
+func (r AnalyticsResult) Matrix(calculation AnalyticsCalculation) (AnalyticsMatrix, error) {
+	var m AnalyticsMatrix
+	b, err := r.calculation(calculation)
+	if err != nil {
+		return m, err
+	}
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(b, &v); err != nil {
+		return m, err
+	}
+	for key, b := range v {
+		var err error
+		if key == "index" {
+			err = json.Unmarshal(b, &m.Index)
+		} else {
+			err = json.Unmarshal(b, &m.Values)
+		}
+		if err != nil {
+			return m, err
+		}
+	}
+	return m, nil
+}
+
+// Window returns a sliding window calculation as values by date by symbol, or
+// by pair of symbols such as "AAPL-MSFT" for AnalyticsCovariance and
+// AnalyticsCorrelation.
+func (r AnalyticsResult) Window(calculation AnalyticsCalculation) (map[string]map[string]float64, error) {
+	b, err := r.calculation(calculation)
+	if err != nil {
+		return nil, err
+	}
+
+	// The values are nested under a single key, such as "RUNNING_MEAN".
+	var v map[string]map[string]map[string]float64
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	for _, values := range v {
+		return values, nil
+	}
+	return nil, nil
+}
+
+// GetAnalyticsFixedWindow returns statistics of the returns of symbols over
+// the whole range.
+//
+// See: https://www.alphavantage.co/documentation/#analytics-fixed-window
+func (c *Client) GetAnalyticsFixedWindow(req AnalyticsRequest) (AnalyticsResult, error) {
+	return c.GetAnalyticsFixedWindowContext(context.Background(), req)
+}
+
+// GetAnalyticsFixedWindowContext is like GetAnalyticsFixedWindow but uses ctx
+// for the request.
+func (c *Client) GetAnalyticsFixedWindowContext(ctx context.Context, req AnalyticsRequest) (r AnalyticsResult, err error) {
+	err = c.getJSON(ctx, "/query", req.values("ANALYTICS_FIXED_WINDOW"), &r)
+	return
+}
+
+// GetAnalyticsSlidingWindow returns statistics of the returns of symbols over
+// a window of windowSize data points sliding across the range.
+//
+// See: https://www.alphavantage.co/documentation/#analytics-sliding-window
+func (c *Client) GetAnalyticsSlidingWindow(req AnalyticsRequest, windowSize int) (AnalyticsResult, error) {
+	return c.GetAnalyticsSlidingWindowContext(context.Background(), req, windowSize)
+}
+
+// GetAnalyticsSlidingWindowContext is like GetAnalyticsSlidingWindow but uses
+// ctx for the request.
+func (c *Client) GetAnalyticsSlidingWindowContext(ctx context.Context, req AnalyticsRequest, windowSize int) (r AnalyticsResult, err error) {
+	query := req.values("ANALYTICS_SLIDING_WINDOW")
+	query.Set("WINDOW_SIZE", strconv.Itoa(windowSize))
+	err = c.getJSON(ctx, "/query", query, &r)
+	return
+}
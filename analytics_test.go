@@ -0,0 +1,154 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetAnalyticsFixedWindow(t *testing.T) {
+	var query url.Values
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"meta_data": {
+				"symbols": "AAPL,MSFT",
+				"min_dt": "2023-07-03",
+				"max_dt": "2023-08-31",
+				"ohlc": "Close",
+				"interval": "DAILY"
+			},
+			"payload": {
+				"RETURNS_CALCULATIONS": {
+					"MEAN": {"AAPL": 0.0002, "MSFT": -0.0003},
+					"CORRELATION": {
+						"index": ["AAPL", "MSFT"],
+						"correlation": [[1.0], [0.57, 1.0]]
+					}
+				}
+			}
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetAnalyticsFixedWindow(AnalyticsRequest{
+		Symbols:      []string{"AAPL", "MSFT"},
+		From:         time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC),
+		Interval:     Interval1Day,
+		OHLC:         SeriesTypeClose,
+		Calculations: []AnalyticsCalculation{AnalyticsMean, AnalyticsCorrelation},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (url.Values{
+		"function":     []string{"ANALYTICS_FIXED_WINDOW"},
+		"SYMBOLS":      []string{"AAPL,MSFT"},
+		"RANGE":        []string{"2023-07-01", "2023-08-31"},
+		"INTERVAL":     []string{"DAILY"},
+		"OHLC":         []string{"close"},
+		"CALCULATIONS": []string{"MEAN,CORRELATION"},
+	}); !reflect.DeepEqual(query, want) {
+		t.Fatalf("got query %v, want %v", query, want)
+	}
+	if want := (AnalyticsMetaData{
+		Symbols:  "AAPL,MSFT",
+		MinDate:  NullDate{time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC), true},
+		MaxDate:  NullDate{time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC), true},
+		OHLC:     "Close",
+		Interval: "DAILY",
+	}); got.MetaData != want {
+		t.Fatalf("got meta data %+v, want %+v", got.MetaData, want)
+	}
+	mean, err := got.Values(AnalyticsMean)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]float64{"AAPL": 0.0002, "MSFT": -0.0003}; !reflect.DeepEqual(mean, want) {
+		t.Fatalf("got mean %v, want %v", mean, want)
+	}
+	correlation, err := got.Matrix(AnalyticsCorrelation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"AAPL", "MSFT"}; !reflect.DeepEqual(correlation.Index, want) {
+		t.Fatalf("got index %v, want %v", correlation.Index, want)
+	}
+	if got := correlation.At(0, 1); got != 0.57 {
+		t.Fatalf("got correlation %v, want 0.57", got)
+	}
+	if _, err := got.Values(AnalyticsMedian); err == nil {
+		t.Fatal("got no error for missing calculation")
+	}
+}
+
+func TestClient_GetAnalyticsSlidingWindow(t *testing.T) {
+	var query url.Values
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"meta_data": {"symbols": "AAPL", "window_size": 20},
+			"payload": {
+				"RETURNS_CALCULATIONS": {
+					"MEAN": {
+						"RUNNING_MEAN": {
+							"AAPL": {"2023-08-31": 0.0011, "2023-08-30": 0.0009}
+						}
+					}
+				}
+			}
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetAnalyticsSlidingWindow(AnalyticsRequest{
+		Symbols:      []string{"AAPL"},
+		Interval:     Interval1Day,
+		Calculations: []AnalyticsCalculation{AnalyticsMean},
+	}, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (url.Values{
+		"function":     []string{"ANALYTICS_SLIDING_WINDOW"},
+		"SYMBOLS":      []string{"AAPL"},
+		"RANGE":        []string{"full"},
+		"INTERVAL":     []string{"DAILY"},
+		"CALCULATIONS": []string{"MEAN"},
+		"WINDOW_SIZE":  []string{"20"},
+	}); !reflect.DeepEqual(query, want) {
+		t.Fatalf("got query %v, want %v", query, want)
+	}
+	mean, err := got.Window(AnalyticsMean)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]map[string]float64{
+		"AAPL": {"2023-08-31": 0.0011, "2023-08-30": 0.0009},
+	}; !reflect.DeepEqual(mean, want) {
+		t.Fatalf("got mean %v, want %v", mean, want)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// An APIErrorKind is the kind of error reported by Alpha Vantage.
+type APIErrorKind int
+
+// Kinds of errors reported by Alpha Vantage.
+const (
+	APIErrorInvalidCall APIErrorKind = iota + 1 // An invalid API call.
+	APIErrorRateLimit                           // The rate limit was exceeded.
+	APIErrorPremium                             // A premium-only endpoint.
+	APIErrorInvalidKey                          // An invalid or missing API key.
+	APIErrorInformation                         // Any other informational message.
+)
+
+// String returns the name of the APIErrorKind.
+func (k APIErrorKind) String() string {
+	switch k {
+	case APIErrorInvalidCall:
+		return "invalid call"
+	case APIErrorRateLimit:
+		return "rate limit"
+	case APIErrorPremium:
+		return "premium"
+	case APIErrorInvalidKey:
+		return "invalid key"
+	case APIErrorInformation:
+		return "information"
+	}
+	return "unknown"
+}
+
+// An APIError is an error reported by Alpha Vantage in the body of an
+// otherwise successful response, i.e. as a "Note", "Error Message" or
+// "Information" payload.
+type APIError struct {
+	Kind    APIErrorKind
+	Message string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return "alphavantage: " + e.Kind.String() + ": " + e.Message
+}
+
+// Is reports whether the APIError matches target. An APIError of kind
+// APIErrorRateLimit matches ErrRateLimitExceeded.
+func (e *APIError) Is(target error) bool {
+	return target == ErrRateLimitExceeded && e.Kind == APIErrorRateLimit
+}
+
+// parseAPIError returns the APIError described by a JSON response body, or nil
+// if the body does not describe one.
+func parseAPIError(b []byte) *APIError {
+	var v struct {
+		Note         *string `json:"Note"`
+		ErrorMessage *string `json:"Error Message"`
+		Information  *string `json:"Information"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+	switch {
+	case v.ErrorMessage != nil:
+		kind := APIErrorInvalidCall
+		if isInvalidKeyMessage(*v.ErrorMessage) {
+			kind = APIErrorInvalidKey
+		}
+		return &APIError{Kind: kind, Message: *v.ErrorMessage}
+	case v.Note != nil:
+		return &APIError{Kind: APIErrorRateLimit, Message: *v.Note}
+	case v.Information != nil:
+		msg := strings.ToLower(*v.Information)
+		kind := APIErrorInformation
+		// Rate limit messages also advertise the premium plans, so they
+		// are checked first.
+		switch {
+		case strings.Contains(msg, "rate limit"),
+			strings.Contains(msg, "call frequency"),
+			strings.Contains(msg, "requests per day"),
+			strings.Contains(msg, "per-second burst"):
+			kind = APIErrorRateLimit
+		case strings.Contains(msg, "premium endpoint"):
+			kind = APIErrorPremium
+		case isInvalidKeyMessage(msg):
+			kind = APIErrorInvalidKey
+		}
+		return &APIError{Kind: kind, Message: *v.Information}
+	}
+	return nil
+}
+
+func isInvalidKeyMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "apikey") &&
+		(strings.Contains(msg, "invalid") || strings.Contains(msg, "missing"))
+}
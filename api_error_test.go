@@ -0,0 +1,100 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestParseAPIError(t *testing.T) {
+	for _, test := range []struct {
+		body string
+		want APIErrorKind
+	}{
+		{`{"Error Message": "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for TIME_SERIES_DAILY."}`, APIErrorInvalidCall},
+		{`{"Error Message": "the parameter apikey is invalid or missing. Please claim your free API key on (https://www.alphavantage.co/support/#api-key)."}`, APIErrorInvalidKey},
+		{`{"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day."}`, APIErrorRateLimit},
+		{`{"Information": "We have detected your API key as demo and our standard API rate limit is 25 requests per day. Please subscribe to any of the premium plans at https://www.alphavantage.co/premium/ to instantly remove all daily rate limits."}`, APIErrorRateLimit},
+		{`{"Information": "Burst pattern detected. Please consider spreading out your free API requests more sparingly (1 request per second). You may subscribe to any of the premium plans at https://www.alphavantage.co/premium/ to lift the free key rate limit (25 requests per day), raise the per-second burst limit, and instantly remove all daily rate limits."}`, APIErrorRateLimit},
+		{`{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint. You may subscribe to any of the premium plans at https://www.alphavantage.co/premium/ to instantly unlock all premium endpoints"}`, APIErrorPremium},
+		{`{"Information": "The demo API key is for demo purposes only."}`, APIErrorInformation},
+	} {
+		err := parseAPIError([]byte(test.body))
+		if err == nil {
+			t.Fatalf("%s: got nil error", test.body)
+		}
+		if err.Kind != test.want {
+			t.Fatalf("%s: got kind %v, want %v", test.body, err.Kind, test.want)
+		}
+		if got, want := errors.Is(err, ErrRateLimitExceeded), test.want == APIErrorRateLimit; got != want {
+			t.Fatalf("%s: got errors.Is(err, ErrRateLimitExceeded) = %v, want %v", test.body, got, want)
+		}
+	}
+	if err := parseAPIError([]byte(`{"Meta Data": {"Information": "Daily Prices"}}`)); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+}
+
+func TestClient_getJSONAPIError(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"Error Message": "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for CURRENCY_EXCHANGE_RATE."
+		}`))
+		return &res, nil
+	}), "")
+	_, err := c.GetExchangeRate("USD", "XXX")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Kind != APIErrorInvalidCall {
+		t.Fatalf("got error %v, want invalid call", err)
+	}
+}
+
+func TestClient_getCSVAPIError(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Header = http.Header{"Content-Type": []string{"application/json; charset=utf-8"}}
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day."
+		}`))
+		return &res, nil
+	}), "")
+	err := c.Search("BA", func(SearchResult) error { return nil })
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("got error %v, want %v", err, ErrRateLimitExceeded)
+	}
+}
+
+func TestClient_getCSVUnexpectedJSON(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Header = http.Header{"Content-Type": []string{"application/json"}}
+		res.Body = ioutil.NopCloser(strings.NewReader(`{"bestMatches": []}`))
+		return &res, nil
+	}), "")
+	err := c.Search("BA", func(SearchResult) error { return nil })
+	if !errors.Is(err, ErrUnexpectedJSON) {
+		t.Fatalf("got error %v, want %v", err, ErrUnexpectedJSON)
+	}
+}
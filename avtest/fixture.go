@@ -0,0 +1,157 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avtest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// A fixture is a recorded response.
+type fixture struct {
+	Request     string `json:"request"`
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	Body        string `json:"body"`
+}
+
+// fixtureKey returns the path and query of a request, excluding the API key.
+func fixtureKey(u *url.URL) string {
+	query := u.Query()
+	query.Del("apikey")
+	return u.Path + "?" + query.Encode()
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// fixturePath returns the path of the fixture for a request in dir. The name
+// begins with the function, or the path for the currency lists, so that
+// fixtures are easy to find.
+func fixturePath(dir string, u *url.URL) string {
+	name := u.Query().Get("function")
+	if name == "" {
+		name = u.Path
+	}
+	name = strings.Trim(unsafeChars.ReplaceAllString(name, "_"), "_")
+	sum := sha256.Sum256([]byte(fixtureKey(u)))
+	return filepath.Join(dir, name+"-"+hex.EncodeToString(sum[:6])+".json")
+}
+
+// A Recorder is a http.RoundTripper that records every response as a fixture
+// in a directory, with the API key scrubbed, for a Replayer to serve later.
+type Recorder struct {
+	// Dir is the fixtures directory, which is created as needed.
+	Dir string
+
+	// Transport sends requests. It defaults to http.DefaultTransport when nil.
+	Transport http.RoundTripper
+}
+
+// NewRecordingClient returns a new HTTP client that records responses as
+// fixtures in dir.
+func NewRecordingClient(dir string) *http.Client {
+	return &http.Client{Transport: &Recorder{Dir: dir}}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the body, then replace it for the caller.
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// Write the fixture.
+	b, err := json.MarshalIndent(fixture{
+		Request:     fixtureKey(req.URL),
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        string(body),
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(r.Dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(fixturePath(r.Dir, req.URL), b, 0644); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// A Replayer is a http.RoundTripper that serves fixtures recorded by a
+// Recorder. It fails any request without a fixture.
+type Replayer struct {
+	// Dir is the fixtures directory.
+	Dir string
+}
+
+// NewReplayingClient returns a new HTTP client that serves fixtures from dir.
+func NewReplayingClient(dir string) *http.Client {
+	return &http.Client{Transport: &Replayer{Dir: dir}}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := fixtureKey(req.URL)
+	path := fixturePath(r.Dir, req.URL)
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("avtest: no fixture for %s in %s", key, r.Dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var f fixture
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("avtest: %s: %v", path, err)
+	}
+	if f.Request != key {
+		return nil, fmt.Errorf("avtest: %s: fixture is for %s, not %s", path, f.Request, key)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{f.ContentType}},
+		Body:          ioutil.NopCloser(strings.NewReader(f.Body)),
+		ContentLength: int64(len(f.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avtest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tradyfinance/alphavantage"
+	"github.com/tradyfinance/alphavantage/avtest"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "avtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := avtest.NewServer()
+	search := func(c *alphavantage.Client) []alphavantage.SearchResult {
+		var results []alphavantage.SearchResult
+		if err := c.Search("BA", func(r alphavantage.SearchResult) error {
+			results = append(results, r)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return results
+	}
+
+	// Record from the fake server.
+	recorded := search(alphavantage.NewClientWithOptions(
+		alphavantage.WithHTTPClient(avtest.NewRecordingClient(dir)),
+		alphavantage.WithAPIKey("secret"),
+		alphavantage.WithBaseURL(s.URL),
+	))
+	s.Close()
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || !strings.HasPrefix(files[0].Name(), "SYMBOL_SEARCH-") {
+		t.Fatalf("got fixtures %v", files)
+	}
+	b, err := ioutil.ReadFile(dir + "/" + files[0].Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret") {
+		t.Fatal("API key was not scrubbed")
+	}
+
+	// Replay with a different API key and no server.
+	c := alphavantage.NewClientWithOptions(
+		alphavantage.WithHTTPClient(avtest.NewReplayingClient(dir)),
+		alphavantage.WithAPIKey("other"),
+		alphavantage.WithBaseURL(s.URL),
+	)
+	if replayed := search(c); !reflect.DeepEqual(replayed, recorded) {
+		t.Fatalf("got %+v, want %+v", replayed, recorded)
+	}
+	if err := c.Search("MSFT", func(alphavantage.SearchResult) error { return nil }); err == nil || !strings.Contains(err.Error(), "no fixture") {
+		t.Fatalf("got error %v, want missing fixture", err)
+	}
+}
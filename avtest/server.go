@@ -0,0 +1,359 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package avtest provides a fake Alpha Vantage server for tests.
+package avtest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tradyfinance/alphavantage"
+)
+
+// A Response is a scripted response of a Server. A zero StatusCode defaults to
+// http.StatusOK and an empty ContentType to JSON.
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        string
+}
+
+// RateLimitNote returns a response reporting that the rate limit was exceeded.
+func RateLimitNote() Response {
+	return jsonResponse(map[string]string{
+		"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day.",
+	})
+}
+
+// ErrorMessage returns a response reporting an invalid API call.
+func ErrorMessage(msg string) Response {
+	return jsonResponse(map[string]string{"Error Message": msg})
+}
+
+// Information returns a response with an informational message, such as one
+// reporting a premium endpoint.
+func Information(msg string) Response {
+	return jsonResponse(map[string]string{"Information": msg})
+}
+
+// HTTPError returns a response with a HTTP status code and no body.
+func HTTPError(statusCode int) Response {
+	return Response{StatusCode: statusCode, ContentType: "text/plain", Body: http.StatusText(statusCode)}
+}
+
+func jsonResponse(v interface{}) Response {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		panic(err)
+	}
+	return Response{StatusCode: http.StatusOK, ContentType: "application/json", Body: string(b)}
+}
+
+// A Server is a fake Alpha Vantage server. It dispatches on the function query
+// parameter (or the path, for the currency lists), serving scripted responses
+// first, then registered fixtures, then synthetic data. Synthetic time series,
+// quotes and search results are only served as CSV; register a fixture to
+// serve them as JSON.
+type Server struct {
+	// URL is the base URL of the server.
+	URL string
+
+	srv      *httptest.Server
+	mu       sync.Mutex
+	script   []Response
+	fixtures map[string]Response
+	requests []url.Values
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when
+// finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{fixtures: map[string]Response{}}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a new client that sends requests to the server, configured
+// by opts.
+func (s *Server) Client(opts ...alphavantage.Option) *alphavantage.Client {
+	return alphavantage.NewClientWithOptions(append([]alphavantage.Option{
+		alphavantage.WithHTTPClient(s.srv.Client()),
+		alphavantage.WithAPIKey("demo"),
+		alphavantage.WithBaseURL(s.URL),
+	}, opts...)...)
+}
+
+// Enqueue appends responses to the script. Each request is served the next
+// scripted response, if any, regardless of its function.
+func (s *Server) Enqueue(rs ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.script = append(s.script, rs...)
+}
+
+// SetFixture sets the body served for a function and datatype ("csv" or
+// "json"). The function is either the value of the function query parameter
+// or a path, such as "/digital_currency_list/".
+func (s *Server) SetFixture(function, datatype, body string) {
+	contentType := "application/json"
+	if datatype == "csv" {
+		contentType = "application/x-download"
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[function+" "+datatype] = Response{http.StatusOK, contentType, body}
+}
+
+// Requests returns the queries of the requests received so far.
+func (s *Server) Requests() []url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]url.Values(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	function := query.Get("function")
+	if function == "" {
+		function = req.URL.Path
+	}
+	datatype := query.Get("datatype")
+	if datatype == "" {
+		datatype = "json"
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, query)
+	resp, ok := Response{}, false
+	if len(s.script) > 0 {
+		resp, ok = s.script[0], true
+		s.script = s.script[1:]
+	} else {
+		resp, ok = s.fixtures[function+" "+datatype]
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		resp = synthesize(function, datatype, query)
+	}
+	if resp.StatusCode == 0 {
+		resp.StatusCode = http.StatusOK
+	}
+	if resp.ContentType == "" {
+		resp.ContentType = "application/json"
+	}
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.WriteHeader(resp.StatusCode)
+	fmt.Fprint(w, resp.Body)
+}
+
+// A table is synthetic tabular data.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// synthesize returns a response with synthetic data for a function.
+func synthesize(function, datatype string, query url.Values) Response {
+	symbol := query.Get("symbol")
+	if symbol == "" {
+		symbol = query.Get("from_symbol") + query.Get("to_symbol")
+	}
+	r := rand.New(rand.NewSource(seed(symbol + query.Get("keywords"))))
+
+	var t table
+	switch {
+	case function == "/digital_currency_list/":
+		t = table{[]string{"currency code", "currency name"}, [][]string{{"BTC", "Bitcoin"}, {"ETH", "Ethereum"}}}
+	case function == "/physical_currency_list/":
+		t = table{[]string{"currency code", "currency name"}, [][]string{{"USD", "United States Dollar"}, {"JPY", "Japanese Yen"}}}
+	case function == "TIME_SERIES_INTRADAY":
+		t = quotes(r, alphavantage.Interval(query.Get("interval")), query, false, true)
+	case strings.HasPrefix(function, "TIME_SERIES_"):
+		interval := alphavantage.Interval(strings.TrimSuffix(strings.TrimPrefix(function, "TIME_SERIES_"), "_ADJUSTED"))
+		t = quotes(r, interval, query, strings.HasSuffix(function, "_ADJUSTED"), true)
+	case function == "FX_INTRADAY":
+		t = quotes(r, alphavantage.Interval(query.Get("interval")), query, false, false)
+	case strings.HasPrefix(function, "FX_"):
+		t = quotes(r, alphavantage.Interval(strings.TrimPrefix(function, "FX_")), query, false, false)
+	case strings.HasPrefix(function, "DIGITAL_CURRENCY_"):
+		t = quotes(r, alphavantage.Interval(strings.TrimPrefix(function, "DIGITAL_CURRENCY_")), query, false, true)
+		for i, h := range t.header {
+			if h != "timestamp" && h != "volume" {
+				t.header[i] = h + " (USD)"
+			}
+		}
+		t.header = append(t.header, "market cap (USD)")
+		for i := range t.rows {
+			t.rows[i] = append(t.rows[i], t.rows[i][4])
+		}
+	case function == "GLOBAL_QUOTE":
+		q := quotes(r, alphavantage.Interval1Day, url.Values{"outputsize": {"compact"}}, false, true)
+		last, prev := q.rows[0], q.rows[1]
+		price, _ := strconv.ParseFloat(last[4], 64)
+		prevClose, _ := strconv.ParseFloat(prev[4], 64)
+		t = table{
+			[]string{"symbol", "open", "high", "low", "price", "volume", "latestDay", "previousClose", "change", "changePercent"},
+			[][]string{{
+				symbol, last[1], last[2], last[3], last[4], last[5], last[0], prev[4],
+				formatFloat(price - prevClose),
+				strconv.FormatFloat((price-prevClose)/prevClose*100, 'f', 4, 64) + "%",
+			}},
+		}
+	case function == "SYMBOL_SEARCH":
+		keywords := strings.ToUpper(query.Get("keywords"))
+		t = table{
+			[]string{"symbol", "name", "type", "region", "marketOpen", "marketClose", "timezone", "currency", "matchScore"},
+			[][]string{
+				{keywords, keywords + " Inc.", "Equity", "United States", "09:30", "16:00", "UTC-04", "USD", "1.0000"},
+				{keywords + "X", keywords + "X Corporation", "Equity", "United States", "09:30", "16:00", "UTC-04", "USD", "0.8000"},
+			},
+		}
+	case function == "CURRENCY_EXCHANGE_RATE":
+		rate := 0.5 + 100*r.Float64()
+		return jsonResponse(map[string]map[string]string{
+			"Realtime Currency Exchange Rate": {
+				"1. From_Currency Code": query.Get("from_currency"),
+				"2. From_Currency Name": query.Get("from_currency"),
+				"3. To_Currency Code":   query.Get("to_currency"),
+				"4. To_Currency Name":   query.Get("to_currency"),
+				"5. Exchange Rate":      formatFloat(rate),
+				"6. Last Refreshed":     epoch.Format("2006-01-02 15:04:05"),
+				"7. Time Zone":          "UTC",
+				"8. Bid Price":          formatFloat(rate * 0.9999),
+				"9. Ask Price":          formatFloat(rate * 1.0001),
+			},
+		})
+	case function == "SECTOR":
+		ranks := []string{
+			"Rank A: Real-Time Performance",
+			"Rank B: 1 Day Performance",
+			"Rank C: 5 Day Performance",
+			"Rank D: 1 Month Performance",
+			"Rank E: 3 Month Performance",
+			"Rank F: Year-to-Date (YTD) Performance",
+			"Rank G: 1 Year Performance",
+			"Rank H: 3 Year Performance",
+			"Rank I: 5 Year Performance",
+			"Rank J: 10 Year Performance",
+		}
+		sectors := []string{
+			"Utilities", "Communication Services", "Real Estate", "Financials",
+			"Consumer Discretionary", "Consumer Staples", "Health Care",
+			"Industrials", "Materials", "Energy", "Information Technology",
+		}
+		v := map[string]map[string]string{}
+		for i, rank := range ranks {
+			v[rank] = map[string]string{}
+			for _, sector := range sectors {
+				v[rank][sector] = strconv.FormatFloat((r.Float64()-0.45)*float64(i+1)*2, 'f', 2, 64) + "%"
+			}
+		}
+		return jsonResponse(v)
+	default:
+		return ErrorMessage("Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for " + function + ".")
+	}
+
+	// Tabular data is only synthesized as CSV, rather than in a JSON shape the
+	// real API never responds with.
+	if datatype != "csv" {
+		return ErrorMessage("avtest: synthetic data for " + function + " is only served as CSV; set a JSON fixture instead.")
+	}
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write(t.header)
+	w.WriteAll(t.rows)
+	return Response{http.StatusOK, "application/x-download", b.String()}
+}
+
+// epoch is the time of the latest synthetic data point.
+var epoch = time.Date(2019, 9, 17, 16, 0, 0, 0, time.UTC)
+
+// quotes returns a synthetic time series of quotes as a random walk, latest
+// first.
+func quotes(r *rand.Rand, interval alphavantage.Interval, query url.Values, adjusted, volume bool) table {
+	n := 100
+	if query.Get("outputsize") == "full" {
+		n = 1000
+	}
+	step := interval.Duration()
+	if step == 0 {
+		step = 24 * time.Hour
+	}
+	layout := "2006-01-02"
+	if step < 24*time.Hour {
+		layout = "2006-01-02 15:04:05"
+	}
+
+	t := table{header: []string{"timestamp", "open", "high", "low", "close"}}
+	if adjusted {
+		t.header = append(t.header, "adjusted_close")
+	}
+	if volume {
+		t.header = append(t.header, "volume")
+	}
+	if adjusted {
+		t.header = append(t.header, "dividend_amount", "split_coefficient")
+	}
+	price := 10 + 190*r.Float64()
+	for i := 0; i < n; i++ {
+		open := price
+		price *= 1 + (r.Float64()-0.5)/50
+		high := math.Max(open, price) * (1 + r.Float64()/100)
+		low := math.Min(open, price) * (1 - r.Float64()/100)
+		row := []string{
+			epoch.Add(-time.Duration(i) * step).Format(layout),
+			formatFloat(open), formatFloat(high), formatFloat(low), formatFloat(price),
+		}
+		if adjusted {
+			row = append(row, formatFloat(price))
+		}
+		if volume {
+			row = append(row, strconv.Itoa(1000000+r.Intn(20000000)))
+		}
+		if adjusted {
+			row = append(row, "0.0000", "1.0000")
+		}
+		t.rows = append(t.rows, row)
+	}
+	return t
+}
+
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'f', 4, 64)
+}
+
+func seed(s string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return int64(h.Sum64())
+}
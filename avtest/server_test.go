@@ -0,0 +1,150 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avtest_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/tradyfinance/alphavantage"
+	"github.com/tradyfinance/alphavantage/avtest"
+	"github.com/tradyfinance/httpext"
+)
+
+func TestServer_synthetic(t *testing.T) {
+	s := avtest.NewServer()
+	defer s.Close()
+	c := s.Client()
+
+	n := 0
+	if err := c.GetStockTimeSeriesAdjusted(
+		"MSFT",
+		alphavantage.Interval1Day,
+		alphavantage.OutputSizeCompact,
+		func(q alphavantage.StockQuoteAdjusted) error {
+			if q.Low > q.High || q.Close <= 0 {
+				t.Fatalf("got invalid quote %+v", q)
+			}
+			n++
+			return nil
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if n != 100 {
+		t.Fatalf("got %d quotes, want 100", n)
+	}
+
+	q, err := c.GetLatestStockQuote("MSFT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Symbol != "MSFT" {
+		t.Fatalf("got symbol %q, want %q", q.Symbol, "MSFT")
+	}
+
+	er, err := c.GetExchangeRate("USD", "JPY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if er.FromCurrencyCode != "USD" || er.ExchangeRate <= 0 {
+		t.Fatalf("got exchange rate %+v", er)
+	}
+
+	if _, err := c.GetSectorPerformances(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_syntheticJSON(t *testing.T) {
+	s := avtest.NewServer()
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/query?function=TIME_SERIES_DAILY&symbol=MSFT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var v map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v["Error Message"] == "" {
+		t.Fatalf("got %v, want an error message", v)
+	}
+}
+
+func TestServer_SetFixture(t *testing.T) {
+	s := avtest.NewServer()
+	defer s.Close()
+	s.SetFixture("/physical_currency_list/", "csv", "currency code,currency name\nEUR,Euro\n")
+
+	var got []alphavantage.Currency
+	if err := s.Client().GetPhysicalCurrencies(func(c alphavantage.Currency) error {
+		got = append(got, c)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Code != "EUR" {
+		t.Fatalf("got %+v", got)
+	}
+	if got := s.Requests()[0].Get("apikey"); got != "demo" {
+		t.Fatalf("got API key %q, want %q", got, "demo")
+	}
+}
+
+func TestServer_Enqueue(t *testing.T) {
+	s := avtest.NewServer()
+	defer s.Close()
+	s.Enqueue(
+		avtest.RateLimitNote(),
+		avtest.ErrorMessage("Invalid API call."),
+		avtest.HTTPError(http.StatusServiceUnavailable),
+	)
+	c := s.Client()
+
+	err := c.Search("BA", func(alphavantage.SearchResult) error { return nil })
+	if !errors.Is(err, alphavantage.ErrRateLimitExceeded) {
+		t.Fatalf("got error %v, want %v", err, alphavantage.ErrRateLimitExceeded)
+	}
+
+	var apiErr *alphavantage.APIError
+	if _, err := c.GetExchangeRate("USD", "XXX"); !errors.As(err, &apiErr) || apiErr.Kind != alphavantage.APIErrorInvalidCall {
+		t.Fatalf("got error %v, want invalid call", err)
+	}
+
+	var statusErr httpext.StatusError
+	if _, err := c.GetSectorPerformances(); !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("got error %v, want status %d", err, http.StatusServiceUnavailable)
+	}
+
+	if err := c.Search("BA", func(alphavantage.SearchResult) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_Enqueue_defaults(t *testing.T) {
+	s := avtest.NewServer()
+	defer s.Close()
+	s.Enqueue(avtest.Response{Body: `{"Error Message": "Invalid API call."}`})
+
+	var apiErr *alphavantage.APIError
+	if _, err := s.Client().GetExchangeRate("USD", "XXX"); !errors.As(err, &apiErr) || apiErr.Kind != alphavantage.APIErrorInvalidCall {
+		t.Fatalf("got error %v, want invalid call", err)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Cache caches response bodies. Keys are normalized request paths and
+// queries that exclude the API key. Implementations must be safe for
+// concurrent use by multiple goroutines.
+type Cache interface {
+	// Get returns the value for key, reporting whether it was found and has
+	// not expired.
+	Get(key string) ([]byte, bool)
+
+	// Set sets the value for key, expiring after ttl. Failures are not
+	// reported, since caching is best-effort.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// DefaultCacheTTL returns how long a response may be cached given the path and
+// query of its request: a day for currency lists and symbol searches, a minute
+// for intraday and realtime data, and an hour for other time series.
+func DefaultCacheTTL(path string, query url.Values) time.Duration {
+	switch path {
+	case "/digital_currency_list/", "/physical_currency_list/":
+		return 24 * time.Hour
+	}
+	function := query.Get("function")
+	switch {
+	case function == "SYMBOL_SEARCH":
+		return 24 * time.Hour
+	case strings.HasSuffix(function, "_INTRADAY"),
+		function == "GLOBAL_QUOTE",
+		function == "CURRENCY_EXCHANGE_RATE",
+		function == "SECTOR":
+		return time.Minute
+	case strings.HasPrefix(function, "TIME_SERIES_"),
+		strings.HasPrefix(function, "FX_"),
+		strings.HasPrefix(function, "DIGITAL_CURRENCY_"):
+		return time.Hour
+	}
+	return time.Minute
+}
+
+// cacheKey returns the cache key for a request path and query.
+func cacheKey(path string, query url.Values) string {
+	q := url.Values{}
+	for k, v := range query {
+		if k != "apikey" {
+			q[k] = v
+		}
+	}
+	return path + "?" + q.Encode()
+}
+
+// cacheTTL returns how long a response may be cached, or zero when the client
+// has no cache.
+func (c *Client) cacheTTL(path string, query url.Values) time.Duration {
+	if c.Cache == nil {
+		return 0
+	}
+	if c.CacheTTL != nil {
+		return c.CacheTTL(path, query)
+	}
+	return DefaultCacheTTL(path, query)
+}
+
+func (c *Client) cacheGet(key string, ttl time.Duration) ([]byte, bool) {
+	if ttl <= 0 {
+		return nil, false
+	}
+	return c.Cache.Get(key)
+}
+
+func (c *Client) cacheSet(key string, value []byte, ttl time.Duration) {
+	if ttl > 0 {
+		c.Cache.Set(key, value, ttl)
+	}
+}
+
+// A MemoryCache is an in-memory Cache that evicts the least recently used
+// entries beyond its size.
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	entries *list.List
+	index   map[string]*list.Element
+	now     func() time.Time
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns a new MemoryCache holding up to size entries.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		entries: list.New(),
+		index:   map[string]*list.Element{},
+		now:     time.Now,
+	}
+}
+
+// Get implements the Cache interface.
+func (mc *MemoryCache) Get(key string) ([]byte, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	e, ok := mc.index[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*memoryCacheEntry)
+	if !mc.now().Before(entry.expires) {
+		mc.entries.Remove(e)
+		delete(mc.index, key)
+		return nil, false
+	}
+	mc.entries.MoveToFront(e)
+	return entry.value, true
+}
+
+// Set implements the Cache interface.
+func (mc *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	entry := &memoryCacheEntry{key, value, mc.now().Add(ttl)}
+	if e, ok := mc.index[key]; ok {
+		e.Value = entry
+		mc.entries.MoveToFront(e)
+		return
+	}
+	mc.index[key] = mc.entries.PushFront(entry)
+	for mc.entries.Len() > mc.size {
+		e := mc.entries.Back()
+		mc.entries.Remove(e)
+		delete(mc.index, e.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// A FileCache is a Cache that stores each entry as a file in a directory.
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache returns a new FileCache storing entries in dir, which is created
+// as needed.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir, time.Now}
+}
+
+// path returns the path of the file for key.
+func (fc *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(fc.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements the Cache interface.
+func (fc *FileCache) Get(key string) ([]byte, bool) {
+	path := fc.path(key)
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	// The first line is the expiry time in Unix nanoseconds.
+	i := bytes.IndexByte(b, '\n')
+	if i < 0 {
+		return nil, false
+	}
+	expires, err := strconv.ParseInt(string(b[:i]), 10, 64)
+	if err != nil || fc.now().UnixNano() >= expires {
+		os.Remove(path)
+		return nil, false
+	}
+	return b[i+1:], true
+}
+
+// Set implements the Cache interface.
+func (fc *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	if err := os.MkdirAll(fc.dir, 0755); err != nil {
+		return
+	}
+
+	// Write to a temporary file first so that readers never see a partially
+	// written entry.
+	f, err := ioutil.TempFile(fc.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	expires := fc.now().Add(ttl).UnixNano()
+	_, err = f.WriteString(strconv.FormatInt(expires, 10) + "\n")
+	if err == nil {
+		_, err = f.Write(value)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), fc.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)
+	mc := NewMemoryCache(2)
+	mc.now = func() time.Time { return now }
+	mc.Set("a", []byte("1"), time.Minute)
+	mc.Set("b", []byte("2"), time.Hour)
+	mc.Get("a")
+	mc.Set("c", []byte("3"), time.Hour)
+	if _, ok := mc.Get("b"); ok {
+		t.Fatal("least recently used entry was not evicted")
+	}
+	if got, ok := mc.Get("a"); !ok || string(got) != "1" {
+		t.Fatalf("got %q, want %q", got, "1")
+	}
+	now = now.Add(time.Minute)
+	if _, ok := mc.Get("a"); ok {
+		t.Fatal("expired entry was returned")
+	}
+	if got, ok := mc.Get("c"); !ok || string(got) != "3" {
+		t.Fatalf("got %q, want %q", got, "3")
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alphavantage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	now := time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)
+	fc := NewFileCache(dir)
+	fc.now = func() time.Time { return now }
+	fc.Set("a", []byte("1\n2"), time.Minute)
+	if got, ok := fc.Get("a"); !ok || string(got) != "1\n2" {
+		t.Fatalf("got %q, want %q", got, "1\n2")
+	}
+	if _, ok := fc.Get("b"); ok {
+		t.Fatal("missing entry was returned")
+	}
+	now = now.Add(time.Minute)
+	if _, ok := fc.Get("a"); ok {
+		t.Fatal("expired entry was returned")
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	n := 0
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		n++
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("currency code,currency name\nBTC,Bitcoin\n"))
+		return &res, nil
+	}), "demo")
+	mc := NewMemoryCache(10)
+	c.Cache = mc
+	for i := 0; i < 2; i++ {
+		got := []Currency{}
+		if err := c.GetDigitalCurrencies(func(c Currency) error {
+			got = append(got, c)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if want := []Currency{{"BTC", "Bitcoin"}}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %+v, want %+v", got, want)
+		}
+	}
+	if n != 1 {
+		t.Fatalf("got %d requests, want 1", n)
+	}
+	if _, ok := mc.Get("/digital_currency_list/?datatype=csv"); !ok {
+		t.Fatal("response was not cached by its normalized query")
+	}
+}
+
+func TestClient_Cache_undecodable(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		body string
+		get  func(c *Client) error
+	}{
+		{
+			name: "JSON",
+			body: "<html>Bad Gateway</html>",
+			get: func(c *Client) error {
+				_, err := c.GetCompanyOverview("MSFT")
+				return err
+			},
+		},
+		{
+			name: "CSV",
+			body: "currency code,currency name\nBTC,\"Bit",
+			get: func(c *Client) error {
+				return c.GetDigitalCurrencies(func(Currency) error { return nil })
+			},
+		},
+	} {
+		c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+			var res http.Response
+			res.StatusCode = http.StatusOK
+			res.Body = ioutil.NopCloser(strings.NewReader(tt.body))
+			return &res, nil
+		}), "demo")
+		mc := NewMemoryCache(10)
+		c.Cache = mc
+		if err := tt.get(c); err == nil {
+			t.Fatalf("%s: got no error for undecodable response", tt.name)
+		}
+		if n := mc.entries.Len(); n != 0 {
+			t.Fatalf("%s: got %d cached responses, want 0", tt.name, n)
+		}
+	}
+}
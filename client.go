@@ -15,10 +15,17 @@
 package alphavantage
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/tradyfinance/csvext"
 	"github.com/tradyfinance/httpext"
@@ -32,6 +39,47 @@ const BaseURL = "https://www.alphavantage.co"
 type Client struct {
 	client *http.Client
 	APIKey string
+
+	// BaseURL overrides the base URL for the API, e.g. to use a caching proxy
+	// or a fake server. It may include a path prefix, such as
+	// "http://localhost:8080/alphavantage". It defaults to the BaseURL constant
+	// when empty.
+	BaseURL string
+
+	// UserAgent, when non-empty, is sent as the User-Agent header.
+	UserAgent string
+
+	// Limiter, when non-nil, paces every request made by the client.
+	Limiter *RateLimiter
+
+	// RetryPolicy, when non-nil, determines how failed requests are retried.
+	RetryPolicy *RetryPolicy
+
+	// Hooks are called around every HTTP request made by the client.
+	Hooks Hooks
+
+	// Cache, when non-nil, caches responses for the duration returned by
+	// CacheTTL, which defaults to DefaultCacheTTL when nil.
+	Cache    Cache
+	CacheTTL func(path string, query url.Values) time.Duration
+
+	// CoalesceRequests makes concurrent identical requests share a single
+	// HTTP request. Responses of streaming methods are then buffered once and
+	// replayed to every callback.
+	CoalesceRequests bool
+
+	flights flightGroup
+}
+
+// Hooks are functions called around every HTTP request made by a Client, e.g.
+// for logging or metrics. Either may be nil.
+type Hooks struct {
+	// BeforeRequest is called before a HTTP request is sent.
+	BeforeRequest func(req *http.Request)
+
+	// AfterResponse is called after a HTTP request is sent, with either its
+	// response or an error. It must not read or close the response body.
+	AfterResponse func(req *http.Request, resp *http.Response, err error)
 }
 
 // NewClient returns a new Client given a HTTP client and API key. The HTTP
@@ -44,62 +92,76 @@ func NewClient(c *http.Client, apiKey string) *Client {
 	if c == nil {
 		c = http.DefaultClient
 	}
-	return &Client{c, apiKey}
+	return &Client{client: c, APIKey: apiKey}
 }
 
 // DefaultClient is the default client.
 var DefaultClient = NewClient(nil, "")
 
-func (c *Client) getJSON(path string, query url.Values, v interface{}) error {
+// endpointURL returns the URL for a path and query string relative to the
+// client's base URL.
+func (c *Client) endpointURL(path string, query url.Values) string {
+	base := c.BaseURL
+	if base == "" {
+		base = BaseURL
+	}
+	u := strings.TrimSuffix(base, "/") + path
+	if queryString := query.Encode(); queryString != "" {
+		u += "?" + queryString
+	}
+	return u
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, query url.Values, v interface{}) error {
 	// Use DefaultClient when nil.
 	if c == nil {
 		c = DefaultClient
 	}
 
 	// Build the query string.
+	if query == nil {
+		query = url.Values{}
+	}
+	key, ttl := cacheKey(path, query), c.cacheTTL(path, query)
 	if c.APIKey != "" {
-		if query == nil {
-			query = url.Values{}
-		}
 		query.Set("apikey", c.APIKey)
 	}
 
 	// Build the URL.
-	url := BaseURL + path
-	if query != nil {
-		if queryString := query.Encode(); queryString != "" {
-			url += "?" + queryString
-		}
-	}
+	url := c.endpointURL(path, query)
 
-	// Create a HTTP request.
-	req, err := http.NewRequest(http.MethodGet, url, nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Accept", "application/json")
-
-	// Send the HTTP request.
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return err
-	}
-	if !httpext.IsSuccessStatus(resp.StatusCode) {
-		return httpext.StatusError{URL: url, StatusCode: resp.StatusCode}
+	// Use a cached response when available.
+	if b, ok := c.cacheGet(key, ttl); ok {
+		return json.Unmarshal(b, v)
 	}
 
-	// Decode JSON from the HTTP response.
-	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-		resp.Body.Close()
-		return err
-	}
-	return resp.Body.Close()
+	return c.retry(ctx, func() (bool, error) {
+		b, err := c.getShared(ctx, key, url, "application/json")
+		if err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal(b, v); err != nil {
+			return false, err
+		}
+		c.cacheSet(key, b, ttl)
+		return false, nil
+	})
 }
 
-// ErrRateLimitExceeded indicates that the rate limit was exceeded.
+// ErrRateLimitExceeded indicates that the rate limit was exceeded. An APIError
+// of kind APIErrorRateLimit matches it when using errors.Is.
 var ErrRateLimitExceeded = errors.New("alphavantage: rate limit exceeded")
 
-func (c *Client) getCSV(path string, query url.Values, f func(header, record []string) error) error {
+// ErrUnexpectedJSON indicates that Alpha Vantage responded with JSON that does
+// not describe an error where CSV was expected.
+var ErrUnexpectedJSON = errors.New("alphavantage: unexpected JSON response")
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+func (c *Client) getCSV(ctx context.Context, path string, query url.Values, f func(header, record []string) error) error {
 	// Use DefaultClient when nil.
 	if c == nil {
 		c = DefaultClient
@@ -109,38 +171,159 @@ func (c *Client) getCSV(path string, query url.Values, f func(header, record []s
 	if query == nil {
 		query = url.Values{}
 	}
+	query.Set("datatype", "csv")
+	key, ttl := cacheKey(path, query), c.cacheTTL(path, query)
 	if c.APIKey != "" {
 		query.Set("apikey", c.APIKey)
 	}
-	query.Set("datatype", "csv")
 
 	// Build the URL.
-	url := BaseURL + path + "?" + query.Encode()
+	url := c.endpointURL(path, query)
+
+	// Buffer the response when it is to be cached or shared, using a cached
+	// response when available.
+	if ttl > 0 || c.CoalesceRequests {
+		if b, ok := c.cacheGet(key, ttl); ok {
+			return readCSV(ctx, bytes.NewReader(b), f)
+		}
+		return c.retry(ctx, func() (bool, error) {
+			b, err := c.getShared(ctx, key, url, "")
+			if err != nil {
+				return false, err
+			}
+
+			// Only cache the response if it parses as a table, whether or
+			// not f fails.
+			var ferr error
+			err = readCSV(ctx, bytes.NewReader(b), func(header, record []string) error {
+				ferr = f(header, record)
+				return ferr
+			})
+			if err == nil || err == ferr {
+				c.cacheSet(key, b, ttl)
+			}
+			return true, err
+		})
+	}
+
+	// Otherwise stream the response, only retrying while no record has been
+	// delivered to f.
+	return c.retry(ctx, func() (bool, error) {
+		delivered := false
+		err := c.streamCSV(ctx, url, func(header, record []string) error {
+			delivered = true
+			return f(header, record)
+		})
+		return delivered, err
+	})
+}
+
+// send sends a GET request for url, returning the response when it has a
+// success status.
+func (c *Client) send(ctx context.Context, url, accept string) (*http.Response, error) {
+	// Wait for the rate limiter.
+	if err := c.Limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 
 	// Create a HTTP request.
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	//req.Header.Set("Accept", "text/csv")
 
 	// Send the HTTP request.
+	if c.Hooks.BeforeRequest != nil {
+		c.Hooks.BeforeRequest(req)
+	}
 	resp, err := c.client.Do(req)
+	if c.Hooks.AfterResponse != nil {
+		c.Hooks.AfterResponse(req, resp, err)
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !httpext.IsSuccessStatus(resp.StatusCode) {
-		return httpext.StatusError{URL: url, StatusCode: resp.StatusCode}
+		resp.Body.Close()
+		return nil, httpext.StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+	return resp, nil
+}
+
+// getBody sends a GET request for url and reads the whole response body,
+// returning an error if the body reports one.
+func (c *Client) getBody(ctx context.Context, url, accept string) ([]byte, error) {
+	// Send the HTTP request.
+	resp, err := c.send(ctx, url, accept)
+	if err != nil {
+		return nil, err
 	}
-	if resp.Header.Get("Content-Type") == "application/json" {
+
+	// Read the HTTP response.
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
 		resp.Body.Close()
-		return ErrRateLimitExceeded
+		return nil, err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	// Check for an error reported in the body. Alpha Vantage responds with
+	// JSON rather than CSV to report errors.
+	if accept == "application/json" || isJSON(resp.Header.Get("Content-Type")) {
+		if err := parseAPIError(b); err != nil {
+			return nil, err
+		}
+		if accept != "application/json" {
+			return nil, ErrUnexpectedJSON
+		}
+	}
+	return b, nil
+}
+
+// streamCSV sends a GET request for url and reads a CSV table from the
+// response as it arrives.
+func (c *Client) streamCSV(ctx context.Context, url string, f func(header, record []string) error) error {
+	// Send the HTTP request.
+	resp, err := c.send(ctx, url, "")
+	if err != nil {
+		return err
+	}
+
+	// Alpha Vantage responds with JSON rather than CSV to report errors.
+	if isJSON(resp.Header.Get("Content-Type")) {
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if err := parseAPIError(b); err != nil {
+			return err
+		}
+		return ErrUnexpectedJSON
 	}
 
 	// Read a CSV table from the HTTP response.
-	if err := csvext.ReadTable(resp.Body, f); err != nil {
+	if err := readCSV(ctx, resp.Body, f); err != nil {
 		resp.Body.Close()
 		return err
 	}
 	return resp.Body.Close()
 }
+
+// readCSV reads a CSV table from r, stopping early if the context is done.
+func readCSV(ctx context.Context, r io.Reader, f func(header, record []string) error) error {
+	return csvext.ReadTable(r, func(header, record []string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return f(header, record)
+	})
+}
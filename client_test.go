@@ -0,0 +1,71 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_getCSVContext(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"currency code,currency name\n" +
+				"BTC,Bitcoin\n" +
+				"ETH,Ethereum\n" +
+				"LTC,Litecoin\n",
+		))
+		return &res, nil
+	}), "")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	n := 0
+	err := c.GetDigitalCurrenciesContext(ctx, func(Currency) error {
+		n++
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	if n != 1 {
+		t.Fatalf("got %d currencies, want 1", n)
+	}
+}
+
+func TestClient_BaseURL(t *testing.T) {
+	var got string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		got = req.URL.String()
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("currency code,currency name\n"))
+		return &res, nil
+	}), "demo")
+	c.BaseURL = "http://localhost:8080/alphavantage/"
+	if err := c.GetPhysicalCurrencies(func(Currency) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if want := "http://localhost:8080/alphavantage/physical_currency_list/?apikey=demo&datatype=csv"; got != want {
+		t.Fatalf("got URL %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// A flightGroup deduplicates concurrent calls with the same key, so that only
+// one is in flight at a time and its result is shared with every caller.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// do calls fn unless a call with the same key is already in flight, in which
+// case it waits for that call's result or for ctx to be done.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*flightCall{}
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.body, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.body, call.err = fn()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+	return call.body, call.err
+}
+
+// getShared is like getBody, but shares the response body with concurrent
+// identical requests when the client coalesces requests.
+func (c *Client) getShared(ctx context.Context, key, url, accept string) ([]byte, error) {
+	if !c.CoalesceRequests {
+		return c.getBody(ctx, url, accept)
+	}
+	for {
+		b, err := c.flights.do(ctx, accept+" "+key, func() ([]byte, error) {
+			return c.getBody(ctx, url, accept)
+		})
+
+		// Try again if the caller that made the shared request gave up.
+		if (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) && ctx.Err() == nil {
+			continue
+		}
+		return b, err
+	}
+}
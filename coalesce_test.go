@@ -0,0 +1,101 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tradyfinance/httpext"
+)
+
+// A joinContext reports when a caller first waits on it, which a caller only
+// does once it has joined the request in flight.
+type joinContext struct {
+	context.Context
+	once   sync.Once
+	joined chan<- struct{}
+}
+
+func (ctx *joinContext) Done() <-chan struct{} {
+	ctx.once.Do(func() { ctx.joined <- struct{}{} })
+	return ctx.Context.Done()
+}
+
+func TestClient_CoalesceRequests(t *testing.T) {
+	var mu sync.Mutex
+	n := 0
+	arrived := make(chan struct{}, 1)
+	release := make(chan struct{})
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		n++
+		mu.Unlock()
+		arrived <- struct{}{}
+		<-release
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"symbol,open,high,low,price,volume,latestDay,previousClose,change,changePercent\n" +
+				"MSFT,136.9600,137.5200,136.4250,137.3900,17274541,2019-09-17,136.3300,1.0600,0.7775%\n",
+		))
+		return &res, nil
+	}), "")
+	c.CoalesceRequests = true
+
+	const callers = 5
+	var wg sync.WaitGroup
+	quotes := make([]LatestStockQuote, callers)
+	errs := make([]error, callers)
+
+	// Make the first request, then have every other caller join it while it
+	// is in flight.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		quotes[0], errs[0] = c.GetLatestStockQuote("MSFT")
+	}()
+	<-arrived
+	joined := make(chan struct{}, callers)
+	for i := 1; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx := &joinContext{Context: context.Background(), joined: joined}
+			quotes[i], errs[i] = c.GetLatestStockQuoteContext(ctx, "MSFT")
+		}(i)
+	}
+	for i := 1; i < callers; i++ {
+		<-joined
+	}
+	close(release)
+	wg.Wait()
+
+	if n != 1 {
+		t.Fatalf("got %d requests, want 1", n)
+	}
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if quotes[i].Symbol != "MSFT" || quotes[i].Price != 137.39 {
+			t.Fatalf("got %+v", quotes[i])
+		}
+	}
+}
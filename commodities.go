@@ -0,0 +1,91 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// A Commodity is a commodity whose global price is tracked.
+//
+// See: https://www.alphavantage.co/documentation/#commodities
+type Commodity string
+
+// Commodities whose global prices are tracked.
+const (
+	CommodityWTI            Commodity = "WTI"
+	CommodityBrent          Commodity = "BRENT"
+	CommodityNaturalGas     Commodity = "NATURAL_GAS"
+	CommodityCopper         Commodity = "COPPER"
+	CommodityAluminum       Commodity = "ALUMINUM"
+	CommodityWheat          Commodity = "WHEAT"
+	CommodityCorn           Commodity = "CORN"
+	CommodityCotton         Commodity = "COTTON"
+	CommoditySugar          Commodity = "SUGAR"
+	CommodityCoffee         Commodity = "COFFEE"
+	CommodityAllCommodities Commodity = "ALL_COMMODITIES"
+)
+
+// Intervals returns the intervals the commodity may be requested at, or nil if
+// it is unknown.
+func (c Commodity) Intervals() []Interval {
+	switch c {
+	case CommodityWTI, CommodityBrent, CommodityNaturalGas:
+		return []Interval{Interval1Day, Interval1Week, Interval1Month}
+	case CommodityCopper, CommodityAluminum, CommodityWheat, CommodityCorn,
+		CommodityCotton, CommoditySugar, CommodityCoffee,
+		CommodityAllCommodities:
+		return []Interval{Interval1Month, Interval1Quarter, Interval1Year}
+	}
+	return nil
+}
+
+// GetCommodity returns the global price series of a commodity. The interval
+// may be left empty to use the default of the API, and must otherwise be among
+// those returned by the commodity's Intervals method.
+//
+// See: https://www.alphavantage.co/documentation/#commodities
+func (c *Client) GetCommodity(commodity Commodity, interval Interval) (DataSeries, error) {
+	return c.GetCommodityContext(context.Background(), commodity, interval)
+}
+
+// GetCommodityContext is like GetCommodity but uses ctx for the request.
+func (c *Client) GetCommodityContext(ctx context.Context, commodity Commodity, interval Interval) (ds DataSeries, err error) {
+	intervals := commodity.Intervals()
+	if intervals == nil {
+		return ds, fmt.Errorf("alphavantage: unknown commodity %q", commodity)
+	}
+	query := url.Values{"function": []string{string(commodity)}}
+	if interval != "" {
+		if !containsInterval(intervals, interval) {
+			return ds, fmt.Errorf("alphavantage: %s does not support interval %q", commodity, interval)
+		}
+		query.Set("interval", strings.ToLower(string(interval)))
+	}
+	err = c.getJSON(ctx, "/query", query, &ds)
+	return
+}
+
+func containsInterval(intervals []Interval, interval Interval) bool {
+	for _, i := range intervals {
+		if i == interval {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetCommodity(t *testing.T) {
+	var query url.Values
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"name": "Global Price of Copper",
+			"interval": "quarterly",
+			"unit": "dollar per metric ton",
+			"data": [
+				{"date": "2023-07-01", "value": "8356.4"},
+				{"date": "2023-04-01", "value": "."}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetCommodity(CommodityCopper, Interval1Quarter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (url.Values{
+		"function": []string{"COPPER"},
+		"interval": []string{"quarterly"},
+	}); !reflect.DeepEqual(query, want) {
+		t.Fatalf("got query %v, want %v", query, want)
+	}
+	if got.Name != "Global Price of Copper" || got.Unit != "dollar per metric ton" {
+		t.Fatalf("got metadata %q, %q", got.Name, got.Unit)
+	}
+	if len(got.Data) != 2 || got.Data[0].Value != (NullFloat64{8356.4, true}) || got.Data[1].Value.Valid {
+		t.Fatalf("got data %+v", got.Data)
+	}
+
+	if _, err := c.GetCommodity(CommodityWTI, Interval1Year); err == nil {
+		t.Fatal("got no error for unsupported interval")
+	}
+	if _, err := c.GetCommodity("GOLD", ""); err == nil {
+		t.Fatal("got no error for unknown commodity")
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+)
+
+// A CompanyOverview is the company information, financial ratios and other key
+// metrics of a company. Numbers that Alpha Vantage reports as missing, e.g. as
+// "None" or "-", are invalid.
+//
+// See: https://www.alphavantage.co/documentation/#company-overview
+type CompanyOverview struct {
+	Symbol                     string      `json:"Symbol"`
+	AssetType                  string      `json:"AssetType"`
+	Name                       string      `json:"Name"`
+	Description                string      `json:"Description"`
+	CIK                        string      `json:"CIK"`
+	Exchange                   string      `json:"Exchange"`
+	Currency                   string      `json:"Currency"`
+	Country                    string      `json:"Country"`
+	Sector                     string      `json:"Sector"`
+	Industry                   string      `json:"Industry"`
+	Address                    string      `json:"Address"`
+	FiscalYearEnd              string      `json:"FiscalYearEnd"` // Month, e.g. "June".
+	LatestQuarter              NullDate    `json:"LatestQuarter"`
+	MarketCapitalization       NullInt64   `json:"MarketCapitalization"`
+	EBITDA                     NullInt64   `json:"EBITDA"`
+	PERatio                    NullFloat64 `json:"PERatio"`
+	PEGRatio                   NullFloat64 `json:"PEGRatio"`
+	BookValue                  NullFloat64 `json:"BookValue"`
+	DividendPerShare           NullFloat64 `json:"DividendPerShare"`
+	DividendYield              NullFloat64 `json:"DividendYield"`
+	EPS                        NullFloat64 `json:"EPS"`
+	RevenuePerShareTTM         NullFloat64 `json:"RevenuePerShareTTM"`
+	ProfitMargin               NullFloat64 `json:"ProfitMargin"`
+	OperatingMarginTTM         NullFloat64 `json:"OperatingMarginTTM"`
+	ReturnOnAssetsTTM          NullFloat64 `json:"ReturnOnAssetsTTM"`
+	ReturnOnEquityTTM          NullFloat64 `json:"ReturnOnEquityTTM"`
+	RevenueTTM                 NullInt64   `json:"RevenueTTM"`
+	GrossProfitTTM             NullInt64   `json:"GrossProfitTTM"`
+	DilutedEPSTTM              NullFloat64 `json:"DilutedEPSTTM"`
+	QuarterlyEarningsGrowthYOY NullFloat64 `json:"QuarterlyEarningsGrowthYOY"`
+	QuarterlyRevenueGrowthYOY  NullFloat64 `json:"QuarterlyRevenueGrowthYOY"`
+	AnalystTargetPrice         NullFloat64 `json:"AnalystTargetPrice"`
+	TrailingPE                 NullFloat64 `json:"TrailingPE"`
+	ForwardPE                  NullFloat64 `json:"ForwardPE"`
+	PriceToSalesRatioTTM       NullFloat64 `json:"PriceToSalesRatioTTM"`
+	PriceToBookRatio           NullFloat64 `json:"PriceToBookRatio"`
+	EVToRevenue                NullFloat64 `json:"EVToRevenue"`
+	EVToEBITDA                 NullFloat64 `json:"EVToEBITDA"`
+	Beta                       NullFloat64 `json:"Beta"`
+	FiftyTwoWeekHigh           NullFloat64 `json:"52WeekHigh"`
+	FiftyTwoWeekLow            NullFloat64 `json:"52WeekLow"`
+	FiftyDayMovingAverage      NullFloat64 `json:"50DayMovingAverage"`
+	TwoHundredDayMovingAverage NullFloat64 `json:"200DayMovingAverage"`
+	SharesOutstanding          NullInt64   `json:"SharesOutstanding"`
+	DividendDate               NullDate    `json:"DividendDate"`
+	ExDividendDate             NullDate    `json:"ExDividendDate"`
+}
+
+// GetCompanyOverview returns the company information, financial ratios and
+// other key metrics of a company.
+//
+// See: https://www.alphavantage.co/documentation/#company-overview
+func (c *Client) GetCompanyOverview(symbol string) (CompanyOverview, error) {
+	return c.GetCompanyOverviewContext(context.Background(), symbol)
+}
+
+// GetCompanyOverviewContext is like GetCompanyOverview but uses ctx for the
+// request.
+func (c *Client) GetCompanyOverviewContext(ctx context.Context, symbol string) (co CompanyOverview, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"OVERVIEW"},
+		"symbol":   []string{symbol},
+	}, &co)
+	return
+}
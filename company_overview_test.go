@@ -0,0 +1,77 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetCompanyOverview(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"Symbol": "IBM",
+			"AssetType": "Common Stock",
+			"Name": "International Business Machines",
+			"Exchange": "NYSE",
+			"Currency": "USD",
+			"Sector": "TECHNOLOGY",
+			"Industry": "COMPUTER & OFFICE EQUIPMENT",
+			"FiscalYearEnd": "December",
+			"LatestQuarter": "2023-09-30",
+			"MarketCapitalization": "135712653000",
+			"PERatio": "22.39",
+			"PEGRatio": "None",
+			"EPS": "6.63",
+			"DividendYield": "0.0449",
+			"ForwardPE": "-",
+			"52WeekHigh": "153.21",
+			"52WeekLow": "119.92",
+			"SharesOutstanding": "911144000",
+			"DividendDate": "2023-12-09",
+			"ExDividendDate": "None"
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetCompanyOverview("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Symbol != "IBM" || got.Sector != "TECHNOLOGY" || got.FiscalYearEnd != "December" {
+		t.Fatalf("got %+v", got)
+	}
+	if want := (NullInt64{135712653000, true}); got.MarketCapitalization != want {
+		t.Fatalf("got market capitalization %+v, want %+v", got.MarketCapitalization, want)
+	}
+	if want := (NullFloat64{22.39, true}); got.PERatio != want {
+		t.Fatalf("got P/E ratio %+v, want %+v", got.PERatio, want)
+	}
+	if want := (NullFloat64{153.21, true}); got.FiftyTwoWeekHigh != want {
+		t.Fatalf("got 52-week high %+v, want %+v", got.FiftyTwoWeekHigh, want)
+	}
+	if got.PEGRatio.Valid || got.ForwardPE.Valid || got.ExDividendDate.Valid || got.Beta.Valid {
+		t.Fatalf("got valid missing values %+v", got)
+	}
+	if want := (NullDate{time.Date(2023, 12, 9, 0, 0, 0, 0, time.UTC), true}); got.DividendDate != want {
+		t.Fatalf("got dividend date %+v, want %+v", got.DividendDate, want)
+	}
+}
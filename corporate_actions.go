@@ -0,0 +1,79 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+)
+
+// A Dividend is a dividend distribution of a company.
+//
+// See: https://www.alphavantage.co/documentation/#dividends
+type Dividend struct {
+	ExDividendDate  NullDate    `json:"ex_dividend_date"`
+	DeclarationDate NullDate    `json:"declaration_date"`
+	RecordDate      NullDate    `json:"record_date"`
+	PaymentDate     NullDate    `json:"payment_date"`
+	Amount          NullFloat64 `json:"amount"`
+}
+
+// GetDividends returns the historical and declared future dividend
+// distributions of a company, latest first.
+//
+// See: https://www.alphavantage.co/documentation/#dividends
+func (c *Client) GetDividends(symbol string) ([]Dividend, error) {
+	return c.GetDividendsContext(context.Background(), symbol)
+}
+
+// GetDividendsContext is like GetDividends but uses ctx for the request.
+func (c *Client) GetDividendsContext(ctx context.Context, symbol string) ([]Dividend, error) {
+	var v struct {
+		Data []Dividend `json:"data"`
+	}
+	err := c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"DIVIDENDS"},
+		"symbol":   []string{symbol},
+	}, &v)
+	return v.Data, err
+}
+
+// A Split is a stock split of a company. A split factor of 2 means each share
+// became two.
+//
+// See: https://www.alphavantage.co/documentation/#splits
+type Split struct {
+	EffectiveDate NullDate    `json:"effective_date"`
+	SplitFactor   NullFloat64 `json:"split_factor"`
+}
+
+// GetSplits returns the historical stock splits of a company, latest first.
+//
+// See: https://www.alphavantage.co/documentation/#splits
+func (c *Client) GetSplits(symbol string) ([]Split, error) {
+	return c.GetSplitsContext(context.Background(), symbol)
+}
+
+// GetSplitsContext is like GetSplits but uses ctx for the request.
+func (c *Client) GetSplitsContext(ctx context.Context, symbol string) ([]Split, error) {
+	var v struct {
+		Data []Split `json:"data"`
+	}
+	err := c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"SPLITS"},
+		"symbol":   []string{symbol},
+	}, &v)
+	return v.Data, err
+}
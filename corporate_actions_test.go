@@ -0,0 +1,98 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetDividends(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"symbol": "IBM",
+			"data": [
+				{"ex_dividend_date": "2024-11-12", "declaration_date": "2024-10-29", "record_date": "2024-11-12", "payment_date": "2024-12-10", "amount": "1.67"},
+				{"ex_dividend_date": "1962-02-06", "declaration_date": "None", "record_date": "None", "payment_date": "None", "amount": "0.0039"}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetDividends("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "function=DIVIDENDS&symbol=IBM"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	date := func(year int, month time.Month, day int) NullDate {
+		return NullDate{time.Date(year, month, day, 0, 0, 0, 0, time.UTC), true}
+	}
+	if want := []Dividend{
+		Dividend{
+			ExDividendDate:  date(2024, 11, 12),
+			DeclarationDate: date(2024, 10, 29),
+			RecordDate:      date(2024, 11, 12),
+			PaymentDate:     date(2024, 12, 10),
+			Amount:          NullFloat64{1.67, true},
+		},
+		Dividend{
+			ExDividendDate: date(1962, 2, 6),
+			Amount:         NullFloat64{0.0039, true},
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_GetSplits(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"symbol": "IBM",
+			"data": [
+				{"effective_date": "2021-11-04", "split_factor": "1.0460"},
+				{"effective_date": "1999-05-27", "split_factor": "2.0000"}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetSplits("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "function=SPLITS&symbol=IBM"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []Split{
+		Split{NullDate{time.Date(2021, 11, 4, 0, 0, 0, 0, time.UTC), true}, NullFloat64{1.046, true}},
+		Split{NullDate{time.Date(1999, 5, 27, 0, 0, 0, 0, time.UTC), true}, NullFloat64{2, true}},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -15,6 +15,7 @@
 package alphavantage
 
 import (
+	"context"
 	"errors"
 	"net/url"
 
@@ -40,6 +41,12 @@ type CryptoQuote struct {
 //
 // See: https://www.alphavantage.co/documentation/#digital-currency
 func (c *Client) GetCryptoTimeSeries(symbol, market string, interval Interval, f func(CryptoQuote) error) error {
+	return c.GetCryptoTimeSeriesContext(context.Background(), symbol, market, interval, f)
+}
+
+// GetCryptoTimeSeriesContext is like GetCryptoTimeSeries but uses ctx for the
+// request.
+func (c *Client) GetCryptoTimeSeriesContext(ctx context.Context, symbol, market string, interval Interval, f func(CryptoQuote) error) error {
 	query := url.Values{
 		"symbol": []string{symbol},
 		"market": []string{market},
@@ -54,6 +61,10 @@ func (c *Client) GetCryptoTimeSeries(symbol, market string, interval Interval, f
 	case Interval30Min:
 		fallthrough
 	case Interval60Min:
+		fallthrough
+	case Interval1Quarter:
+		fallthrough
+	case Interval1Year:
 		return errors.New("only daily, weekly, and monthly intervals are supported for cryptocurrencies on Alpha Vantage")
 	case Interval1Day:
 		fallthrough
@@ -62,7 +73,7 @@ func (c *Client) GetCryptoTimeSeries(symbol, market string, interval Interval, f
 	case Interval1Month:
 		query.Set("function", "DIGITAL_CURRENCY_"+string(interval))
 	}
-	return c.getCSV("/query", query, func(header, record []string) error {
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
 		var q CryptoQuote
 		if err := csvext.UnmarshalRecord(header, record, &q); err != nil {
 			return err
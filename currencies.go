@@ -14,7 +14,11 @@
 
 package alphavantage
 
-import "github.com/tradyfinance/csvext"
+import (
+	"context"
+
+	"github.com/tradyfinance/csvext"
+)
 
 // A Currency is a digital or physical currency.
 type Currency struct {
@@ -30,10 +34,25 @@ func GetDigitalCurrencies(f func(Currency) error) error {
 	return DefaultClient.GetDigitalCurrencies(f)
 }
 
+// GetDigitalCurrenciesContext is like GetDigitalCurrencies but uses ctx for the
+// request.
+//
+// GetDigitalCurrenciesContext is a wrapper around
+// DefaultClient.GetDigitalCurrenciesContext.
+func GetDigitalCurrenciesContext(ctx context.Context, f func(Currency) error) error {
+	return DefaultClient.GetDigitalCurrenciesContext(ctx, f)
+}
+
 // GetDigitalCurrencies gets a list of digital currencies, calling f for each
 // currency.
 func (c *Client) GetDigitalCurrencies(f func(Currency) error) error {
-	return c.getCSV("/digital_currency_list/", nil, func(header, record []string) error {
+	return c.GetDigitalCurrenciesContext(context.Background(), f)
+}
+
+// GetDigitalCurrenciesContext is like GetDigitalCurrencies but uses ctx for the
+// request.
+func (c *Client) GetDigitalCurrenciesContext(ctx context.Context, f func(Currency) error) error {
+	return c.getCSV(ctx, "/digital_currency_list/", nil, func(header, record []string) error {
 		var c Currency
 		if err := csvext.UnmarshalRecord(header, record, &c); err != nil {
 			return err
@@ -51,10 +70,25 @@ func GetPhysicalCurrencies(f func(Currency) error) error {
 	return DefaultClient.GetPhysicalCurrencies(f)
 }
 
+// GetPhysicalCurrenciesContext is like GetPhysicalCurrencies but uses ctx for
+// the request.
+//
+// GetPhysicalCurrenciesContext is a wrapper around
+// DefaultClient.GetPhysicalCurrenciesContext.
+func GetPhysicalCurrenciesContext(ctx context.Context, f func(Currency) error) error {
+	return DefaultClient.GetPhysicalCurrenciesContext(ctx, f)
+}
+
 // GetPhysicalCurrencies gets a list of physical currencies, calling f for each
 // currency.
 func (c *Client) GetPhysicalCurrencies(f func(Currency) error) error {
-	return c.getCSV("/physical_currency_list/", nil, func(header, record []string) error {
+	return c.GetPhysicalCurrenciesContext(context.Background(), f)
+}
+
+// GetPhysicalCurrenciesContext is like GetPhysicalCurrencies but uses ctx for
+// the request.
+func (c *Client) GetPhysicalCurrenciesContext(ctx context.Context, f func(Currency) error) error {
+	return c.getCSV(ctx, "/physical_currency_list/", nil, func(header, record []string) error {
 		var c Currency
 		if err := csvext.UnmarshalRecord(header, record, &c); err != nil {
 			return err
@@ -0,0 +1,228 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tradyfinance/csvext"
+	"github.com/tradyfinance/marshaler"
+)
+
+// An HTSinePoint is a data point of the Hilbert transform sine wave (HT_SINE).
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type HTSinePoint struct {
+	Timestamp marshaler.FlexibleTime `csv:"time"`
+	Sine      float64                `csv:"SINE"`
+	LeadSine  float64                `csv:"LEAD SINE"`
+}
+
+// An HTPhasorPoint is a data point of the Hilbert transform phasor components
+// (HT_PHASOR).
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type HTPhasorPoint struct {
+	Timestamp  marshaler.FlexibleTime `csv:"time"`
+	Phase      float64                `csv:"PHASE"`
+	Quadrature float64                `csv:"QUADRATURE"`
+}
+
+// An HTTrendlineRequest is a request for Hilbert transform instantaneous
+// trendline (HT_TRENDLINE) data.
+//
+// See: https://www.alphavantage.co/documentation/#ht-trendline
+type HTTrendlineRequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+}
+
+func (r HTTrendlineRequest) query() url.Values {
+	query := indicatorQuery("HT_TRENDLINE", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetHTTrendline gets Hilbert transform instantaneous trendline (HT_TRENDLINE)
+// data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#ht-trendline
+func (c *Client) GetHTTrendline(req HTTrendlineRequest, f func(IndicatorPoint) error) error {
+	return c.GetHTTrendlineContext(context.Background(), req, f)
+}
+
+// GetHTTrendlineContext is like GetHTTrendline but uses ctx for the request.
+func (c *Client) GetHTTrendlineContext(ctx context.Context, req HTTrendlineRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An HTSineRequest is a request for Hilbert transform sine wave (HT_SINE) data.
+//
+// See: https://www.alphavantage.co/documentation/#ht-sine
+type HTSineRequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+}
+
+func (r HTSineRequest) query() url.Values {
+	query := indicatorQuery("HT_SINE", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetHTSine gets Hilbert transform sine wave (HT_SINE) data, calling f for each
+// data point.
+//
+// See: https://www.alphavantage.co/documentation/#ht-sine
+func (c *Client) GetHTSine(req HTSineRequest, f func(HTSinePoint) error) error {
+	return c.GetHTSineContext(context.Background(), req, f)
+}
+
+// GetHTSineContext is like GetHTSine but uses ctx for the request.
+func (c *Client) GetHTSineContext(ctx context.Context, req HTSineRequest, f func(HTSinePoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p HTSinePoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// An HTTrendModeRequest is a request for Hilbert transform trend vs. cycle mode
+// (HT_TRENDMODE) data.
+//
+// See: https://www.alphavantage.co/documentation/#ht-trendmode
+type HTTrendModeRequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+}
+
+func (r HTTrendModeRequest) query() url.Values {
+	query := indicatorQuery("HT_TRENDMODE", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetHTTrendMode gets Hilbert transform trend vs. cycle mode (HT_TRENDMODE)
+// data, calling f for each data point. Each value is 1 in a trend and 0 in a
+// cycle.
+//
+// See: https://www.alphavantage.co/documentation/#ht-trendmode
+func (c *Client) GetHTTrendMode(req HTTrendModeRequest, f func(IndicatorPoint) error) error {
+	return c.GetHTTrendModeContext(context.Background(), req, f)
+}
+
+// GetHTTrendModeContext is like GetHTTrendMode but uses ctx for the request.
+func (c *Client) GetHTTrendModeContext(ctx context.Context, req HTTrendModeRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An HTDCPeriodRequest is a request for Hilbert transform dominant cycle period
+// (HT_DCPERIOD) data.
+//
+// See: https://www.alphavantage.co/documentation/#ht-dcperiod
+type HTDCPeriodRequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+}
+
+func (r HTDCPeriodRequest) query() url.Values {
+	query := indicatorQuery("HT_DCPERIOD", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetHTDCPeriod gets Hilbert transform dominant cycle period (HT_DCPERIOD)
+// data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#ht-dcperiod
+func (c *Client) GetHTDCPeriod(req HTDCPeriodRequest, f func(IndicatorPoint) error) error {
+	return c.GetHTDCPeriodContext(context.Background(), req, f)
+}
+
+// GetHTDCPeriodContext is like GetHTDCPeriod but uses ctx for the request.
+func (c *Client) GetHTDCPeriodContext(ctx context.Context, req HTDCPeriodRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An HTDCPhaseRequest is a request for Hilbert transform dominant cycle phase
+// (HT_DCPHASE) data.
+//
+// See: https://www.alphavantage.co/documentation/#ht-dcphase
+type HTDCPhaseRequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+}
+
+func (r HTDCPhaseRequest) query() url.Values {
+	query := indicatorQuery("HT_DCPHASE", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetHTDCPhase gets Hilbert transform dominant cycle phase (HT_DCPHASE) data,
+// calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#ht-dcphase
+func (c *Client) GetHTDCPhase(req HTDCPhaseRequest, f func(IndicatorPoint) error) error {
+	return c.GetHTDCPhaseContext(context.Background(), req, f)
+}
+
+// GetHTDCPhaseContext is like GetHTDCPhase but uses ctx for the request.
+func (c *Client) GetHTDCPhaseContext(ctx context.Context, req HTDCPhaseRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An HTPhasorRequest is a request for Hilbert transform phasor components
+// (HT_PHASOR) data.
+//
+// See: https://www.alphavantage.co/documentation/#ht-phasor
+type HTPhasorRequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+}
+
+func (r HTPhasorRequest) query() url.Values {
+	query := indicatorQuery("HT_PHASOR", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetHTPhasor gets Hilbert transform phasor components (HT_PHASOR) data,
+// calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#ht-phasor
+func (c *Client) GetHTPhasor(req HTPhasorRequest, f func(HTPhasorPoint) error) error {
+	return c.GetHTPhasorContext(context.Background(), req, f)
+}
+
+// GetHTPhasorContext is like GetHTPhasor but uses ctx for the request.
+func (c *Client) GetHTPhasorContext(ctx context.Context, req HTPhasorRequest, f func(HTPhasorPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p HTPhasorPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetHTSine(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"time,LEAD SINE,SINE\n" +
+				"2019-09-17,0.2316,-0.4856\n",
+		))
+		return &res, nil
+	}), "")
+	got := []HTSinePoint{}
+	if err := c.GetHTSine(HTSineRequest{
+		Symbol:     "MSFT",
+		Interval:   Interval1Day,
+		SeriesType: SeriesTypeClose,
+	}, func(p HTSinePoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []HTSinePoint{
+		HTSinePoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)),
+			Sine:      -0.4856,
+			LeadSine:  0.2316,
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_GetHTPhasor(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"time,PHASE,QUADRATURE\n" +
+				"2019-09-17,1.0892,-2.4937\n",
+		))
+		return &res, nil
+	}), "")
+	got := []HTPhasorPoint{}
+	if err := c.GetHTPhasor(HTPhasorRequest{
+		Symbol:     "MSFT",
+		Interval:   Interval1Week,
+		SeriesType: SeriesTypeClose,
+	}, func(p HTPhasorPoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&function=HT_PHASOR&interval=weekly&series_type=close&symbol=MSFT"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []HTPhasorPoint{
+		HTPhasorPoint{
+			Timestamp:  marshaler.FlexibleTime(time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)),
+			Phase:      1.0892,
+			Quadrature: -2.4937,
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_singleValueCycleIndicators(t *testing.T) {
+	for _, tt := range []struct {
+		function string
+		get      func(c *Client, f func(IndicatorPoint) error) error
+	}{
+		{"HT_TRENDLINE", func(c *Client, f func(IndicatorPoint) error) error {
+			return c.GetHTTrendline(HTTrendlineRequest{"MSFT", Interval1Day, SeriesTypeClose}, f)
+		}},
+		{"HT_TRENDMODE", func(c *Client, f func(IndicatorPoint) error) error {
+			return c.GetHTTrendMode(HTTrendModeRequest{"MSFT", Interval1Day, SeriesTypeClose}, f)
+		}},
+		{"HT_DCPERIOD", func(c *Client, f func(IndicatorPoint) error) error {
+			return c.GetHTDCPeriod(HTDCPeriodRequest{"MSFT", Interval1Day, SeriesTypeClose}, f)
+		}},
+		{"HT_DCPHASE", func(c *Client, f func(IndicatorPoint) error) error {
+			return c.GetHTDCPhase(HTDCPhaseRequest{"MSFT", Interval1Day, SeriesTypeClose}, f)
+		}},
+	} {
+		var query string
+		c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			var res http.Response
+			res.StatusCode = http.StatusOK
+			res.Body = ioutil.NopCloser(strings.NewReader("time," + tt.function + "\n2019-09-17,21.5237\n"))
+			return &res, nil
+		}), "")
+		if err := tt.get(c, func(p IndicatorPoint) error {
+			if p.Values[tt.function] != 21.5237 {
+				t.Errorf("%s: got values %v", tt.function, p.Values)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("%s: %v", tt.function, err)
+		}
+		if want := "datatype=csv&function=" + tt.function + "&interval=daily&series_type=close&symbol=MSFT"; query != want {
+			t.Errorf("%s: got query %q, want %q", tt.function, query, want)
+		}
+	}
+}
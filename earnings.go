@@ -0,0 +1,116 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tradyfinance/csvext"
+	"github.com/tradyfinance/marshaler"
+)
+
+// An AnnualEarnings is the reported earnings per share of a company for a
+// fiscal year.
+//
+// See: https://www.alphavantage.co/documentation/#earnings
+type AnnualEarnings struct {
+	FiscalDateEnding marshaler.Date `json:"fiscalDateEnding"`
+	ReportedEPS      NullFloat64    `json:"reportedEPS"`
+}
+
+// A QuarterlyEarnings is the reported and estimated earnings per share of a
+// company for a fiscal quarter.
+//
+// See: https://www.alphavantage.co/documentation/#earnings
+type QuarterlyEarnings struct {
+	FiscalDateEnding   marshaler.Date `json:"fiscalDateEnding"`
+	ReportedDate       NullDate       `json:"reportedDate"`
+	ReportedEPS        NullFloat64    `json:"reportedEPS"`
+	EstimatedEPS       NullFloat64    `json:"estimatedEPS"`
+	Surprise           NullFloat64    `json:"surprise"`
+	SurprisePercentage NullFloat64    `json:"surprisePercentage"`
+}
+
+// Earnings are the annual and quarterly earnings of a company, latest first.
+//
+// See: https://www.alphavantage.co/documentation/#earnings
+type Earnings struct {
+	Symbol            string              `json:"symbol"`
+	AnnualEarnings    []AnnualEarnings    `json:"annualEarnings"`
+	QuarterlyEarnings []QuarterlyEarnings `json:"quarterlyEarnings"`
+}
+
+// GetEarnings returns the annual and quarterly earnings of a company.
+//
+// See: https://www.alphavantage.co/documentation/#earnings
+func (c *Client) GetEarnings(symbol string) (Earnings, error) {
+	return c.GetEarningsContext(context.Background(), symbol)
+}
+
+// GetEarningsContext is like GetEarnings but uses ctx for the request.
+func (c *Client) GetEarningsContext(ctx context.Context, symbol string) (e Earnings, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"EARNINGS"},
+		"symbol":   []string{symbol},
+	}, &e)
+	return
+}
+
+// A Horizon is how far ahead a calendar looks.
+type Horizon string
+
+// How far ahead calendars look.
+const (
+	Horizon3Month  Horizon = "3month"
+	Horizon6Month  Horizon = "6month"
+	Horizon12Month Horizon = "12month"
+)
+
+// An EarningsAnnouncement is an expected earnings announcement of a company.
+//
+// See: https://www.alphavantage.co/documentation/#earnings-calendar
+type EarningsAnnouncement struct {
+	Symbol           string         `csv:"symbol"`
+	Name             string         `csv:"name"`
+	ReportDate       marshaler.Date `csv:"reportDate"`
+	FiscalDateEnding marshaler.Date `csv:"fiscalDateEnding"`
+	Estimate         NullFloat64    `csv:"estimate"`
+	Currency         string         `csv:"currency"`
+}
+
+// GetEarningsCalendar gets the expected earnings announcements within a
+// horizon, calling f for each announcement. The symbol may be left empty to get
+// the announcements of all companies.
+//
+// See: https://www.alphavantage.co/documentation/#earnings-calendar
+func (c *Client) GetEarningsCalendar(symbol string, horizon Horizon, f func(EarningsAnnouncement) error) error {
+	return c.GetEarningsCalendarContext(context.Background(), symbol, horizon, f)
+}
+
+// GetEarningsCalendarContext is like GetEarningsCalendar but uses ctx for the
+// request.
+func (c *Client) GetEarningsCalendarContext(ctx context.Context, symbol string, horizon Horizon, f func(EarningsAnnouncement) error) error {
+	query := url.Values{"function": []string{"EARNINGS_CALENDAR"}}
+	setString(query, "symbol", symbol)
+	setString(query, "horizon", string(horizon))
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
+		var a EarningsAnnouncement
+		if err := csvext.UnmarshalRecord(header, record, &a); err != nil {
+			return err
+		}
+		return f(a)
+	})
+}
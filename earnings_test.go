@@ -0,0 +1,134 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetEarnings(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"symbol": "IBM",
+			"annualEarnings": [
+				{"fiscalDateEnding": "2023-09-30", "reportedEPS": "6.88"}
+			],
+			"quarterlyEarnings": [
+				{
+					"fiscalDateEnding": "2023-09-30",
+					"reportedDate": "2023-10-25",
+					"reportedEPS": "2.2",
+					"estimatedEPS": "2.13",
+					"surprise": "0.07",
+					"surprisePercentage": "3.2864"
+				},
+				{
+					"fiscalDateEnding": "1996-03-31",
+					"reportedDate": "1996-04-16",
+					"reportedEPS": "1.01",
+					"estimatedEPS": "None",
+					"surprise": "0",
+					"surprisePercentage": "None"
+				}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetEarnings("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Earnings{
+		Symbol: "IBM",
+		AnnualEarnings: []AnnualEarnings{
+			AnnualEarnings{
+				FiscalDateEnding: marshaler.Date(time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)),
+				ReportedEPS:      NullFloat64{6.88, true},
+			},
+		},
+		QuarterlyEarnings: []QuarterlyEarnings{
+			QuarterlyEarnings{
+				FiscalDateEnding:   marshaler.Date(time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)),
+				ReportedDate:       NullDate{time.Date(2023, 10, 25, 0, 0, 0, 0, time.UTC), true},
+				ReportedEPS:        NullFloat64{2.2, true},
+				EstimatedEPS:       NullFloat64{2.13, true},
+				Surprise:           NullFloat64{0.07, true},
+				SurprisePercentage: NullFloat64{3.2864, true},
+			},
+			QuarterlyEarnings{
+				FiscalDateEnding: marshaler.Date(time.Date(1996, 3, 31, 0, 0, 0, 0, time.UTC)),
+				ReportedDate:     NullDate{time.Date(1996, 4, 16, 0, 0, 0, 0, time.UTC), true},
+				ReportedEPS:      NullFloat64{1.01, true},
+				Surprise:         NullFloat64{0, true},
+			},
+		},
+	}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_GetEarningsCalendar(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"symbol,name,reportDate,fiscalDateEnding,estimate,currency\n" +
+				"IBM,International Business Machines Corp,2024-01-24,2023-12-31,3.78,USD\n" +
+				"IBM,International Business Machines Corp,2024-04-17,2024-03-31,,USD\n",
+		))
+		return &res, nil
+	}), "")
+	got := []EarningsAnnouncement{}
+	if err := c.GetEarningsCalendar("IBM", Horizon6Month, func(a EarningsAnnouncement) error {
+		got = append(got, a)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&function=EARNINGS_CALENDAR&horizon=6month&symbol=IBM"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []EarningsAnnouncement{
+		EarningsAnnouncement{
+			Symbol:           "IBM",
+			Name:             "International Business Machines Corp",
+			ReportDate:       marshaler.Date(time.Date(2024, 1, 24, 0, 0, 0, 0, time.UTC)),
+			FiscalDateEnding: marshaler.Date(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)),
+			Estimate:         NullFloat64{3.78, true},
+			Currency:         "USD",
+		},
+		EarningsAnnouncement{
+			Symbol:           "IBM",
+			Name:             "International Business Machines Corp",
+			ReportDate:       marshaler.Date(time.Date(2024, 4, 17, 0, 0, 0, 0, time.UTC)),
+			FiscalDateEnding: marshaler.Date(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)),
+			Currency:         "USD",
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
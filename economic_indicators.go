@@ -0,0 +1,171 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/tradyfinance/marshaler"
+)
+
+// An EconomicIndicator is a key US economic indicator.
+//
+// See: https://www.alphavantage.co/documentation/#economic-indicators
+type EconomicIndicator string
+
+// Key US economic indicators.
+const (
+	EconomicIndicatorRealGDP          EconomicIndicator = "REAL_GDP"
+	EconomicIndicatorRealGDPPerCapita EconomicIndicator = "REAL_GDP_PER_CAPITA"
+	EconomicIndicatorTreasuryYield    EconomicIndicator = "TREASURY_YIELD"
+	EconomicIndicatorFederalFundsRate EconomicIndicator = "FEDERAL_FUNDS_RATE"
+	EconomicIndicatorCPI              EconomicIndicator = "CPI"
+	EconomicIndicatorInflation        EconomicIndicator = "INFLATION"
+	EconomicIndicatorRetailSales      EconomicIndicator = "RETAIL_SALES"
+	EconomicIndicatorDurables         EconomicIndicator = "DURABLES"
+	EconomicIndicatorUnemployment     EconomicIndicator = "UNEMPLOYMENT"
+	EconomicIndicatorNonfarmPayroll   EconomicIndicator = "NONFARM_PAYROLL"
+)
+
+// An EconomicInterval is the interval between the data points of an economic
+// indicator.
+type EconomicInterval string
+
+// Intervals between the data points of economic indicators.
+const (
+	EconomicIntervalDaily      EconomicInterval = "daily"
+	EconomicIntervalWeekly     EconomicInterval = "weekly"
+	EconomicIntervalMonthly    EconomicInterval = "monthly"
+	EconomicIntervalQuarterly  EconomicInterval = "quarterly"
+	EconomicIntervalSemiannual EconomicInterval = "semiannual"
+	EconomicIntervalAnnual     EconomicInterval = "annual"
+)
+
+// A Maturity is the maturity of a US treasury.
+type Maturity string
+
+// Maturities of US treasuries.
+const (
+	Maturity3Month Maturity = "3month"
+	Maturity2Year  Maturity = "2year"
+	Maturity5Year  Maturity = "5year"
+	Maturity7Year  Maturity = "7year"
+	Maturity10Year Maturity = "10year"
+	Maturity30Year Maturity = "30year"
+)
+
+// Intervals returns the intervals the indicator may be requested at, or nil if
+// it only has a single interval.
+func (i EconomicIndicator) Intervals() []EconomicInterval {
+	switch i {
+	case EconomicIndicatorRealGDP:
+		return []EconomicInterval{EconomicIntervalQuarterly, EconomicIntervalAnnual}
+	case EconomicIndicatorTreasuryYield, EconomicIndicatorFederalFundsRate:
+		return []EconomicInterval{EconomicIntervalDaily, EconomicIntervalWeekly, EconomicIntervalMonthly}
+	case EconomicIndicatorCPI:
+		return []EconomicInterval{EconomicIntervalMonthly, EconomicIntervalSemiannual}
+	}
+	return nil
+}
+
+// Maturities returns the maturities the indicator may be requested for, or nil
+// if it has none.
+func (i EconomicIndicator) Maturities() []Maturity {
+	if i == EconomicIndicatorTreasuryYield {
+		return []Maturity{Maturity3Month, Maturity2Year, Maturity5Year, Maturity7Year, Maturity10Year, Maturity30Year}
+	}
+	return nil
+}
+
+// valid reports whether i is a known economic indicator.
+func (i EconomicIndicator) valid() bool {
+	switch i {
+	case EconomicIndicatorRealGDP, EconomicIndicatorRealGDPPerCapita,
+		EconomicIndicatorTreasuryYield, EconomicIndicatorFederalFundsRate,
+		EconomicIndicatorCPI, EconomicIndicatorInflation,
+		EconomicIndicatorRetailSales, EconomicIndicatorDurables,
+		EconomicIndicatorUnemployment, EconomicIndicatorNonfarmPayroll:
+		return true
+	}
+	return false
+}
+
+// A DataPoint is a dated value of a data series. Value is not valid where the
+// value is missing.
+type DataPoint struct {
+	Date  marshaler.Date `json:"date"`
+	Value NullFloat64    `json:"value"`
+}
+
+// A DataSeries is a named series of dated values, latest first.
+type DataSeries struct {
+	Name     string      `json:"name"`
+	Interval string      `json:"interval"`
+	Unit     string      `json:"unit"`
+	Data     []DataPoint `json:"data"`
+}
+
+// GetEconomicIndicator returns the data series for an economic indicator. The
+// interval and maturity may be left empty to use the defaults of the API, and
+// must otherwise be among those returned by the indicator's Intervals and
+// Maturities methods.
+//
+// See: https://www.alphavantage.co/documentation/#economic-indicators
+func (c *Client) GetEconomicIndicator(indicator EconomicIndicator, interval EconomicInterval, maturity Maturity) (DataSeries, error) {
+	return c.GetEconomicIndicatorContext(context.Background(), indicator, interval, maturity)
+}
+
+// GetEconomicIndicatorContext is like GetEconomicIndicator but uses ctx for the
+// request.
+func (c *Client) GetEconomicIndicatorContext(ctx context.Context, indicator EconomicIndicator, interval EconomicInterval, maturity Maturity) (ds DataSeries, err error) {
+	if !indicator.valid() {
+		return ds, fmt.Errorf("alphavantage: unknown economic indicator %q", indicator)
+	}
+	query := url.Values{"function": []string{string(indicator)}}
+	if interval != "" {
+		if !containsEconomicInterval(indicator.Intervals(), interval) {
+			return ds, fmt.Errorf("alphavantage: %s does not support interval %q", indicator, interval)
+		}
+		query.Set("interval", string(interval))
+	}
+	if maturity != "" {
+		if !containsMaturity(indicator.Maturities(), maturity) {
+			return ds, fmt.Errorf("alphavantage: %s does not support maturity %q", indicator, maturity)
+		}
+		query.Set("maturity", string(maturity))
+	}
+	err = c.getJSON(ctx, "/query", query, &ds)
+	return
+}
+
+func containsEconomicInterval(intervals []EconomicInterval, interval EconomicInterval) bool {
+	for _, i := range intervals {
+		if i == interval {
+			return true
+		}
+	}
+	return false
+}
+
+func containsMaturity(maturities []Maturity, maturity Maturity) bool {
+	for _, m := range maturities {
+		if m == maturity {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetEconomicIndicator(t *testing.T) {
+	var query url.Values
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"name": "10-Year Treasury Constant Maturity Rate",
+			"interval": "daily",
+			"unit": "percent",
+			"data": [
+				{"date": "2023-07-04", "value": "."},
+				{"date": "2023-07-03", "value": "3.86"}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetEconomicIndicator(EconomicIndicatorTreasuryYield, EconomicIntervalDaily, Maturity10Year)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (url.Values{
+		"function": []string{"TREASURY_YIELD"},
+		"interval": []string{"daily"},
+		"maturity": []string{"10year"},
+	}); !reflect.DeepEqual(query, want) {
+		t.Fatalf("got query %v, want %v", query, want)
+	}
+	if got.Name != "10-Year Treasury Constant Maturity Rate" || got.Interval != "daily" || got.Unit != "percent" {
+		t.Fatalf("got metadata %q, %q, %q", got.Name, got.Interval, got.Unit)
+	}
+	if len(got.Data) != 2 {
+		t.Fatalf("got %d data points, want 2", len(got.Data))
+	}
+	if got.Data[0].Value.Valid {
+		t.Fatalf("got value %v for missing value, want gap", got.Data[0].Value.Float64)
+	}
+	if want := (NullFloat64{3.86, true}); got.Data[1].Value != want {
+		t.Fatalf("got value %+v, want %+v", got.Data[1].Value, want)
+	}
+	if want := marshaler.Date(time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC)); got.Data[1].Date != want {
+		t.Fatalf("got date %v, want %v", got.Data[1].Date, want)
+	}
+}
+
+func TestClient_GetEconomicIndicator_invalid(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}), "")
+	for _, tt := range []struct {
+		indicator EconomicIndicator
+		interval  EconomicInterval
+		maturity  Maturity
+	}{
+		{"GDP", "", ""},
+		{EconomicIndicatorRealGDP, EconomicIntervalDaily, ""},
+		{EconomicIndicatorUnemployment, EconomicIntervalMonthly, ""},
+		{EconomicIndicatorCPI, "", Maturity10Year},
+	} {
+		if _, err := c.GetEconomicIndicator(tt.indicator, tt.interval, tt.maturity); err == nil {
+			t.Errorf("GetEconomicIndicator(%q, %q, %q) succeeded, want error", tt.indicator, tt.interval, tt.maturity)
+		}
+	}
+}
+
+func TestClient_GetEconomicIndicator_function(t *testing.T) {
+	var function string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		function = req.URL.Query().Get("function")
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{"name": "", "interval": "", "unit": "", "data": []}`))
+		return &res, nil
+	}), "")
+	for _, tt := range []struct {
+		indicator EconomicIndicator
+		function  string
+	}{
+		{EconomicIndicatorRealGDP, "REAL_GDP"},
+		{EconomicIndicatorRealGDPPerCapita, "REAL_GDP_PER_CAPITA"},
+		{EconomicIndicatorTreasuryYield, "TREASURY_YIELD"},
+		{EconomicIndicatorFederalFundsRate, "FEDERAL_FUNDS_RATE"},
+		{EconomicIndicatorCPI, "CPI"},
+		{EconomicIndicatorInflation, "INFLATION"},
+		{EconomicIndicatorRetailSales, "RETAIL_SALES"},
+		{EconomicIndicatorDurables, "DURABLES"},
+		{EconomicIndicatorUnemployment, "UNEMPLOYMENT"},
+		{EconomicIndicatorNonfarmPayroll, "NONFARM_PAYROLL"},
+	} {
+		function = ""
+		if _, err := c.GetEconomicIndicator(tt.indicator, "", ""); err != nil {
+			t.Errorf("GetEconomicIndicator(%s): %v", tt.function, err)
+			continue
+		}
+		if function != tt.function {
+			t.Errorf("GetEconomicIndicator(%s) sent function %q", tt.function, function)
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package alphavantage
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 
@@ -93,8 +94,13 @@ func (er *ExchangeRate) UnmarshalJSON(b []byte) error {
 }
 
 // GetExchangeRate returns the exchange rate for a currency pair.
-func (c *Client) GetExchangeRate(from, to string) (er ExchangeRate, err error) {
-	err = c.getJSON("/query", url.Values{
+func (c *Client) GetExchangeRate(from, to string) (ExchangeRate, error) {
+	return c.GetExchangeRateContext(context.Background(), from, to)
+}
+
+// GetExchangeRateContext is like GetExchangeRate but uses ctx for the request.
+func (c *Client) GetExchangeRateContext(ctx context.Context, from, to string) (er ExchangeRate, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{
 		"function":      []string{"CURRENCY_EXCHANGE_RATE"},
 		"from_currency": []string{from},
 		"to_currency":   []string{to},
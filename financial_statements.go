@@ -0,0 +1,220 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tradyfinance/marshaler"
+)
+
+// An IncomeStatement is an income statement of a company. Line items that
+// Alpha Vantage reports as "None" are invalid.
+//
+// See: https://www.alphavantage.co/documentation/#income-statement
+type IncomeStatement struct {
+	FiscalDateEnding                  marshaler.Date `json:"fiscalDateEnding"`
+	ReportedCurrency                  string         `json:"reportedCurrency"`
+	GrossProfit                       NullFloat64    `json:"grossProfit"`
+	TotalRevenue                      NullFloat64    `json:"totalRevenue"`
+	CostOfRevenue                     NullFloat64    `json:"costOfRevenue"`
+	CostOfGoodsAndServicesSold        NullFloat64    `json:"costofGoodsAndServicesSold"`
+	OperatingIncome                   NullFloat64    `json:"operatingIncome"`
+	SellingGeneralAndAdministrative   NullFloat64    `json:"sellingGeneralAndAdministrative"`
+	ResearchAndDevelopment            NullFloat64    `json:"researchAndDevelopment"`
+	OperatingExpenses                 NullFloat64    `json:"operatingExpenses"`
+	InvestmentIncomeNet               NullFloat64    `json:"investmentIncomeNet"`
+	NetInterestIncome                 NullFloat64    `json:"netInterestIncome"`
+	InterestIncome                    NullFloat64    `json:"interestIncome"`
+	InterestExpense                   NullFloat64    `json:"interestExpense"`
+	NonInterestIncome                 NullFloat64    `json:"nonInterestIncome"`
+	OtherNonOperatingIncome           NullFloat64    `json:"otherNonOperatingIncome"`
+	Depreciation                      NullFloat64    `json:"depreciation"`
+	DepreciationAndAmortization       NullFloat64    `json:"depreciationAndAmortization"`
+	IncomeBeforeTax                   NullFloat64    `json:"incomeBeforeTax"`
+	IncomeTaxExpense                  NullFloat64    `json:"incomeTaxExpense"`
+	InterestAndDebtExpense            NullFloat64    `json:"interestAndDebtExpense"`
+	NetIncomeFromContinuingOperations NullFloat64    `json:"netIncomeFromContinuingOperations"`
+	ComprehensiveIncomeNetOfTax       NullFloat64    `json:"comprehensiveIncomeNetOfTax"`
+	EBIT                              NullFloat64    `json:"ebit"`
+	EBITDA                            NullFloat64    `json:"ebitda"`
+	NetIncome                         NullFloat64    `json:"netIncome"`
+}
+
+// IncomeStatements are the annual and quarterly income statements of a company,
+// latest first.
+//
+// See: https://www.alphavantage.co/documentation/#income-statement
+type IncomeStatements struct {
+	Symbol           string            `json:"symbol"`
+	AnnualReports    []IncomeStatement `json:"annualReports"`
+	QuarterlyReports []IncomeStatement `json:"quarterlyReports"`
+}
+
+// GetIncomeStatements returns the annual and quarterly income statements of a
+// company.
+//
+// See: https://www.alphavantage.co/documentation/#income-statement
+func (c *Client) GetIncomeStatements(symbol string) (IncomeStatements, error) {
+	return c.GetIncomeStatementsContext(context.Background(), symbol)
+}
+
+// GetIncomeStatementsContext is like GetIncomeStatements but uses ctx for the
+// request.
+func (c *Client) GetIncomeStatementsContext(ctx context.Context, symbol string) (s IncomeStatements, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"INCOME_STATEMENT"},
+		"symbol":   []string{symbol},
+	}, &s)
+	return
+}
+
+// A BalanceSheet is a balance sheet of a company. Line items that Alpha
+// Vantage reports as "None" are invalid.
+//
+// See: https://www.alphavantage.co/documentation/#balance-sheet
+type BalanceSheet struct {
+	FiscalDateEnding                       marshaler.Date `json:"fiscalDateEnding"`
+	ReportedCurrency                       string         `json:"reportedCurrency"`
+	TotalAssets                            NullFloat64    `json:"totalAssets"`
+	TotalCurrentAssets                     NullFloat64    `json:"totalCurrentAssets"`
+	CashAndCashEquivalentsAtCarryingValue  NullFloat64    `json:"cashAndCashEquivalentsAtCarryingValue"`
+	CashAndShortTermInvestments            NullFloat64    `json:"cashAndShortTermInvestments"`
+	Inventory                              NullFloat64    `json:"inventory"`
+	CurrentNetReceivables                  NullFloat64    `json:"currentNetReceivables"`
+	TotalNonCurrentAssets                  NullFloat64    `json:"totalNonCurrentAssets"`
+	PropertyPlantEquipment                 NullFloat64    `json:"propertyPlantEquipment"`
+	AccumulatedDepreciationAmortizationPPE NullFloat64    `json:"accumulatedDepreciationAmortizationPPE"`
+	IntangibleAssets                       NullFloat64    `json:"intangibleAssets"`
+	IntangibleAssetsExcludingGoodwill      NullFloat64    `json:"intangibleAssetsExcludingGoodwill"`
+	Goodwill                               NullFloat64    `json:"goodwill"`
+	Investments                            NullFloat64    `json:"investments"`
+	LongTermInvestments                    NullFloat64    `json:"longTermInvestments"`
+	ShortTermInvestments                   NullFloat64    `json:"shortTermInvestments"`
+	OtherCurrentAssets                     NullFloat64    `json:"otherCurrentAssets"`
+	OtherNonCurrentAssets                  NullFloat64    `json:"otherNonCurrentAssets"`
+	TotalLiabilities                       NullFloat64    `json:"totalLiabilities"`
+	TotalCurrentLiabilities                NullFloat64    `json:"totalCurrentLiabilities"`
+	CurrentAccountsPayable                 NullFloat64    `json:"currentAccountsPayable"`
+	DeferredRevenue                        NullFloat64    `json:"deferredRevenue"`
+	CurrentDebt                            NullFloat64    `json:"currentDebt"`
+	ShortTermDebt                          NullFloat64    `json:"shortTermDebt"`
+	TotalNonCurrentLiabilities             NullFloat64    `json:"totalNonCurrentLiabilities"`
+	CapitalLeaseObligations                NullFloat64    `json:"capitalLeaseObligations"`
+	LongTermDebt                           NullFloat64    `json:"longTermDebt"`
+	CurrentLongTermDebt                    NullFloat64    `json:"currentLongTermDebt"`
+	LongTermDebtNoncurrent                 NullFloat64    `json:"longTermDebtNoncurrent"`
+	ShortLongTermDebtTotal                 NullFloat64    `json:"shortLongTermDebtTotal"`
+	OtherCurrentLiabilities                NullFloat64    `json:"otherCurrentLiabilities"`
+	OtherNonCurrentLiabilities             NullFloat64    `json:"otherNonCurrentLiabilities"`
+	TotalShareholderEquity                 NullFloat64    `json:"totalShareholderEquity"`
+	TreasuryStock                          NullFloat64    `json:"treasuryStock"`
+	RetainedEarnings                       NullFloat64    `json:"retainedEarnings"`
+	CommonStock                            NullFloat64    `json:"commonStock"`
+	CommonStockSharesOutstanding           NullFloat64    `json:"commonStockSharesOutstanding"`
+}
+
+// BalanceSheets are the annual and quarterly balance sheets of a company,
+// latest first.
+//
+// See: https://www.alphavantage.co/documentation/#balance-sheet
+type BalanceSheets struct {
+	Symbol           string         `json:"symbol"`
+	AnnualReports    []BalanceSheet `json:"annualReports"`
+	QuarterlyReports []BalanceSheet `json:"quarterlyReports"`
+}
+
+// GetBalanceSheets returns the annual and quarterly balance sheets of a
+// company.
+//
+// See: https://www.alphavantage.co/documentation/#balance-sheet
+func (c *Client) GetBalanceSheets(symbol string) (BalanceSheets, error) {
+	return c.GetBalanceSheetsContext(context.Background(), symbol)
+}
+
+// GetBalanceSheetsContext is like GetBalanceSheets but uses ctx for the
+// request.
+func (c *Client) GetBalanceSheetsContext(ctx context.Context, symbol string) (s BalanceSheets, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"BALANCE_SHEET"},
+		"symbol":   []string{symbol},
+	}, &s)
+	return
+}
+
+// A CashFlowStatement is a cash flow statement of a company. Line items that
+// Alpha Vantage reports as "None" are invalid.
+//
+// See: https://www.alphavantage.co/documentation/#cash-flow
+type CashFlowStatement struct {
+	FiscalDateEnding                                          marshaler.Date `json:"fiscalDateEnding"`
+	ReportedCurrency                                          string         `json:"reportedCurrency"`
+	OperatingCashFlow                                         NullFloat64    `json:"operatingCashflow"`
+	PaymentsForOperatingActivities                            NullFloat64    `json:"paymentsForOperatingActivities"`
+	ProceedsFromOperatingActivities                           NullFloat64    `json:"proceedsFromOperatingActivities"`
+	ChangeInOperatingLiabilities                              NullFloat64    `json:"changeInOperatingLiabilities"`
+	ChangeInOperatingAssets                                   NullFloat64    `json:"changeInOperatingAssets"`
+	DepreciationDepletionAndAmortization                      NullFloat64    `json:"depreciationDepletionAndAmortization"`
+	CapitalExpenditures                                       NullFloat64    `json:"capitalExpenditures"`
+	ChangeInReceivables                                       NullFloat64    `json:"changeInReceivables"`
+	ChangeInInventory                                         NullFloat64    `json:"changeInInventory"`
+	ProfitLoss                                                NullFloat64    `json:"profitLoss"`
+	CashFlowFromInvestment                                    NullFloat64    `json:"cashflowFromInvestment"`
+	CashFlowFromFinancing                                     NullFloat64    `json:"cashflowFromFinancing"`
+	ProceedsFromRepaymentsOfShortTermDebt                     NullFloat64    `json:"proceedsFromRepaymentsOfShortTermDebt"`
+	PaymentsForRepurchaseOfCommonStock                        NullFloat64    `json:"paymentsForRepurchaseOfCommonStock"`
+	PaymentsForRepurchaseOfEquity                             NullFloat64    `json:"paymentsForRepurchaseOfEquity"`
+	PaymentsForRepurchaseOfPreferredStock                     NullFloat64    `json:"paymentsForRepurchaseOfPreferredStock"`
+	DividendPayout                                            NullFloat64    `json:"dividendPayout"`
+	DividendPayoutCommonStock                                 NullFloat64    `json:"dividendPayoutCommonStock"`
+	DividendPayoutPreferredStock                              NullFloat64    `json:"dividendPayoutPreferredStock"`
+	ProceedsFromIssuanceOfCommonStock                         NullFloat64    `json:"proceedsFromIssuanceOfCommonStock"`
+	ProceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet NullFloat64    `json:"proceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet"`
+	ProceedsFromIssuanceOfPreferredStock                      NullFloat64    `json:"proceedsFromIssuanceOfPreferredStock"`
+	ProceedsFromRepurchaseOfEquity                            NullFloat64    `json:"proceedsFromRepurchaseOfEquity"`
+	ProceedsFromSaleOfTreasuryStock                           NullFloat64    `json:"proceedsFromSaleOfTreasuryStock"`
+	ChangeInCashAndCashEquivalents                            NullFloat64    `json:"changeInCashAndCashEquivalents"`
+	ChangeInExchangeRate                                      NullFloat64    `json:"changeInExchangeRate"`
+	NetIncome                                                 NullFloat64    `json:"netIncome"`
+}
+
+// CashFlowStatements are the annual and quarterly cash flow statements of a
+// company, latest first.
+//
+// See: https://www.alphavantage.co/documentation/#cash-flow
+type CashFlowStatements struct {
+	Symbol           string              `json:"symbol"`
+	AnnualReports    []CashFlowStatement `json:"annualReports"`
+	QuarterlyReports []CashFlowStatement `json:"quarterlyReports"`
+}
+
+// GetCashFlowStatements returns the annual and quarterly cash flow statements
+// of a company.
+//
+// See: https://www.alphavantage.co/documentation/#cash-flow
+func (c *Client) GetCashFlowStatements(symbol string) (CashFlowStatements, error) {
+	return c.GetCashFlowStatementsContext(context.Background(), symbol)
+}
+
+// GetCashFlowStatementsContext is like GetCashFlowStatements but uses ctx for
+// the request.
+func (c *Client) GetCashFlowStatementsContext(ctx context.Context, symbol string) (s CashFlowStatements, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"CASH_FLOW"},
+		"symbol":   []string{symbol},
+	}, &s)
+	return
+}
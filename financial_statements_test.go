@@ -0,0 +1,266 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetIncomeStatements(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"symbol": "IBM",
+			"annualReports": [
+				{
+					"fiscalDateEnding": "2022-12-31",
+					"reportedCurrency": "USD",
+					"grossProfit": "32687000000",
+					"totalRevenue": "60530000000",
+					"investmentIncomeNet": "None",
+					"netIncome": "1783000000"
+				}
+			],
+			"quarterlyReports": [
+				{
+					"fiscalDateEnding": "2023-09-30",
+					"reportedCurrency": "USD",
+					"grossProfit": "8175000000",
+					"totalRevenue": "14752000000",
+					"investmentIncomeNet": "None",
+					"netIncome": "1704000000"
+				}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetIncomeStatements("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Symbol != "IBM" || len(got.AnnualReports) != 1 || len(got.QuarterlyReports) != 1 {
+		t.Fatalf("got %+v", got)
+	}
+	report := got.QuarterlyReports[0]
+	if want := marshaler.Date(time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)); report.FiscalDateEnding != want {
+		t.Fatalf("got fiscal date ending %v, want %v", report.FiscalDateEnding, want)
+	}
+	if report.ReportedCurrency != "USD" {
+		t.Fatalf("got reported currency %q, want %q", report.ReportedCurrency, "USD")
+	}
+	if want := (NullFloat64{14752000000, true}); report.TotalRevenue != want {
+		t.Fatalf("got total revenue %+v, want %+v", report.TotalRevenue, want)
+	}
+	if report.InvestmentIncomeNet.Valid || report.EBITDA.Valid {
+		t.Fatalf("got valid missing line items %+v", report)
+	}
+}
+
+func TestClient_GetBalanceSheets(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"symbol": "IBM",
+			"annualReports": [
+				{
+					"fiscalDateEnding": "2022-12-31",
+					"reportedCurrency": "USD",
+					"totalAssets": "127243000000",
+					"totalCurrentAssets": "29118000000",
+					"cashAndCashEquivalentsAtCarryingValue": "7886000000",
+					"cashAndShortTermInvestments": "8738000000",
+					"inventory": "1552000000",
+					"currentNetReceivables": "14209000000",
+					"totalNonCurrentAssets": "96874000000",
+					"propertyPlantEquipment": "5334000000",
+					"accumulatedDepreciationAmortizationPPE": "13361000000",
+					"intangibleAssets": "67133000000",
+					"intangibleAssetsExcludingGoodwill": "11184000000",
+					"goodwill": "55949000000",
+					"investments": "None",
+					"longTermInvestments": "159000000",
+					"shortTermInvestments": "852000000",
+					"otherCurrentAssets": "2611000000",
+					"otherNonCurrentAssets": "None",
+					"totalLiabilities": "105222000000",
+					"totalCurrentLiabilities": "31505000000",
+					"currentAccountsPayable": "4051000000",
+					"deferredRevenue": "15531000000",
+					"currentDebt": "9511000000",
+					"shortTermDebt": "4760000000",
+					"totalNonCurrentLiabilities": "83414000000",
+					"capitalLeaseObligations": "164000000",
+					"longTermDebt": "47190000000",
+					"currentLongTermDebt": "4760000000",
+					"longTermDebtNoncurrent": "46189000000",
+					"shortLongTermDebtTotal": "107624000000",
+					"otherCurrentLiabilities": "9140000000",
+					"otherNonCurrentLiabilities": "12243000000",
+					"totalShareholderEquity": "21944000000",
+					"treasuryStock": "169484000000",
+					"retainedEarnings": "149825000000",
+					"commonStock": "58343000000",
+					"commonStockSharesOutstanding": "906091977"
+				}
+			],
+			"quarterlyReports": []
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetBalanceSheets("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "function=BALANCE_SHEET&symbol=IBM"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if got.Symbol != "IBM" || len(got.AnnualReports) != 1 || len(got.QuarterlyReports) != 0 {
+		t.Fatalf("got %+v", got)
+	}
+	if want := (BalanceSheet{
+		FiscalDateEnding:                       marshaler.Date(time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ReportedCurrency:                       "USD",
+		TotalAssets:                            NullFloat64{127243000000, true},
+		TotalCurrentAssets:                     NullFloat64{29118000000, true},
+		CashAndCashEquivalentsAtCarryingValue:  NullFloat64{7886000000, true},
+		CashAndShortTermInvestments:            NullFloat64{8738000000, true},
+		Inventory:                              NullFloat64{1552000000, true},
+		CurrentNetReceivables:                  NullFloat64{14209000000, true},
+		TotalNonCurrentAssets:                  NullFloat64{96874000000, true},
+		PropertyPlantEquipment:                 NullFloat64{5334000000, true},
+		AccumulatedDepreciationAmortizationPPE: NullFloat64{13361000000, true},
+		IntangibleAssets:                       NullFloat64{67133000000, true},
+		IntangibleAssetsExcludingGoodwill:      NullFloat64{11184000000, true},
+		Goodwill:                               NullFloat64{55949000000, true},
+		LongTermInvestments:                    NullFloat64{159000000, true},
+		ShortTermInvestments:                   NullFloat64{852000000, true},
+		OtherCurrentAssets:                     NullFloat64{2611000000, true},
+		TotalLiabilities:                       NullFloat64{105222000000, true},
+		TotalCurrentLiabilities:                NullFloat64{31505000000, true},
+		CurrentAccountsPayable:                 NullFloat64{4051000000, true},
+		DeferredRevenue:                        NullFloat64{15531000000, true},
+		CurrentDebt:                            NullFloat64{9511000000, true},
+		ShortTermDebt:                          NullFloat64{4760000000, true},
+		TotalNonCurrentLiabilities:             NullFloat64{83414000000, true},
+		CapitalLeaseObligations:                NullFloat64{164000000, true},
+		LongTermDebt:                           NullFloat64{47190000000, true},
+		CurrentLongTermDebt:                    NullFloat64{4760000000, true},
+		LongTermDebtNoncurrent:                 NullFloat64{46189000000, true},
+		ShortLongTermDebtTotal:                 NullFloat64{107624000000, true},
+		OtherCurrentLiabilities:                NullFloat64{9140000000, true},
+		OtherNonCurrentLiabilities:             NullFloat64{12243000000, true},
+		TotalShareholderEquity:                 NullFloat64{21944000000, true},
+		TreasuryStock:                          NullFloat64{169484000000, true},
+		RetainedEarnings:                       NullFloat64{149825000000, true},
+		CommonStock:                            NullFloat64{58343000000, true},
+		CommonStockSharesOutstanding:           NullFloat64{906091977, true},
+	}); got.AnnualReports[0] != want {
+		t.Fatalf("got %+v, want %+v", got.AnnualReports[0], want)
+	}
+}
+
+func TestClient_GetCashFlowStatements(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"symbol": "IBM",
+			"annualReports": [
+				{
+					"fiscalDateEnding": "2022-12-31",
+					"reportedCurrency": "USD",
+					"operatingCashflow": "10435000000",
+					"paymentsForOperatingActivities": "1376000000",
+					"proceedsFromOperatingActivities": "None",
+					"changeInOperatingLiabilities": "1071000000",
+					"changeInOperatingAssets": "-1149000000",
+					"depreciationDepletionAndAmortization": "4802000000",
+					"capitalExpenditures": "1346000000",
+					"changeInReceivables": "-1286000000",
+					"changeInInventory": "-71000000",
+					"profitLoss": "1639000000",
+					"cashflowFromInvestment": "-4202000000",
+					"cashflowFromFinancing": "-6440000000",
+					"proceedsFromRepaymentsOfShortTermDebt": "-949000000",
+					"paymentsForRepurchaseOfCommonStock": "407000000",
+					"paymentsForRepurchaseOfEquity": "407000000",
+					"paymentsForRepurchaseOfPreferredStock": "None",
+					"dividendPayout": "5948000000",
+					"dividendPayoutCommonStock": "5948000000",
+					"dividendPayoutPreferredStock": "None",
+					"proceedsFromIssuanceOfCommonStock": "None",
+					"proceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet": "6922000000",
+					"proceedsFromIssuanceOfPreferredStock": "None",
+					"proceedsFromRepurchaseOfEquity": "-407000000",
+					"proceedsFromSaleOfTreasuryStock": "None",
+					"changeInCashAndCashEquivalents": "-207000000",
+					"changeInExchangeRate": "None",
+					"netIncome": "1639000000"
+				}
+			],
+			"quarterlyReports": []
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetCashFlowStatements("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "function=CASH_FLOW&symbol=IBM"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if got.Symbol != "IBM" || len(got.AnnualReports) != 1 || len(got.QuarterlyReports) != 0 {
+		t.Fatalf("got %+v", got)
+	}
+	if want := (CashFlowStatement{
+		FiscalDateEnding:                      marshaler.Date(time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ReportedCurrency:                      "USD",
+		OperatingCashFlow:                     NullFloat64{10435000000, true},
+		PaymentsForOperatingActivities:        NullFloat64{1376000000, true},
+		ChangeInOperatingLiabilities:          NullFloat64{1071000000, true},
+		ChangeInOperatingAssets:               NullFloat64{-1149000000, true},
+		DepreciationDepletionAndAmortization:  NullFloat64{4802000000, true},
+		CapitalExpenditures:                   NullFloat64{1346000000, true},
+		ChangeInReceivables:                   NullFloat64{-1286000000, true},
+		ChangeInInventory:                     NullFloat64{-71000000, true},
+		ProfitLoss:                            NullFloat64{1639000000, true},
+		CashFlowFromInvestment:                NullFloat64{-4202000000, true},
+		CashFlowFromFinancing:                 NullFloat64{-6440000000, true},
+		ProceedsFromRepaymentsOfShortTermDebt: NullFloat64{-949000000, true},
+		PaymentsForRepurchaseOfCommonStock:    NullFloat64{407000000, true},
+		PaymentsForRepurchaseOfEquity:         NullFloat64{407000000, true},
+		DividendPayout:                        NullFloat64{5948000000, true},
+		DividendPayoutCommonStock:             NullFloat64{5948000000, true},
+		ProceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet: NullFloat64{6922000000, true},
+		ProceedsFromRepurchaseOfEquity:                            NullFloat64{-407000000, true},
+		ChangeInCashAndCashEquivalents:                            NullFloat64{-207000000, true},
+		NetIncome:                                                 NullFloat64{1639000000, true},
+	}); got.AnnualReports[0] != want {
+		t.Fatalf("got %+v, want %+v", got.AnnualReports[0], want)
+	}
+}
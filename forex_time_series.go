@@ -15,6 +15,8 @@
 package alphavantage
 
 import (
+	"context"
+	"errors"
 	"net/url"
 
 	"github.com/tradyfinance/csvext"
@@ -36,6 +38,12 @@ type ForexQuote struct {
 //
 // See: https://www.alphavantage.co/documentation/#fx
 func (c *Client) GetForexTimeSeries(from, to string, interval Interval, outputSize OutputSize, f func(ForexQuote) error) error {
+	return c.GetForexTimeSeriesContext(context.Background(), from, to, interval, outputSize, f)
+}
+
+// GetForexTimeSeriesContext is like GetForexTimeSeries but uses ctx for the
+// request.
+func (c *Client) GetForexTimeSeriesContext(ctx context.Context, from, to string, interval Interval, outputSize OutputSize, f func(ForexQuote) error) error {
 	query := url.Values{
 		"from_symbol": []string{from},
 		"to_symbol":   []string{to},
@@ -59,8 +67,12 @@ func (c *Client) GetForexTimeSeries(from, to string, interval Interval, outputSi
 		fallthrough
 	case Interval1Month:
 		query.Set("function", "FX_"+string(interval))
+	case Interval1Quarter:
+		fallthrough
+	case Interval1Year:
+		return errors.New("only intraday, daily, weekly, and monthly intervals are supported for forex on Alpha Vantage")
 	}
-	return c.getCSV("/query", query, func(header, record []string) error {
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
 		var q ForexQuote
 		if err := csvext.UnmarshalRecord(header, record, &q); err != nil {
 			return err
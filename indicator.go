@@ -0,0 +1,136 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/tradyfinance/csvext"
+	"github.com/tradyfinance/marshaler"
+)
+
+// A SeriesType is the price series a technical indicator is calculated from.
+type SeriesType string
+
+// Price series technical indicators are calculated from.
+const (
+	SeriesTypeOpen  SeriesType = "open"
+	SeriesTypeHigh  SeriesType = "high"
+	SeriesTypeLow   SeriesType = "low"
+	SeriesTypeClose SeriesType = "close"
+)
+
+// An IndicatorPoint is a data point of a technical indicator.
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type IndicatorPoint struct {
+	Timestamp marshaler.FlexibleTime
+	Values    map[string]float64 // Values by name, e.g. "SMA".
+}
+
+// Value returns the value of a single-valued indicator, i.e. any value when
+// there is exactly one.
+func (p IndicatorPoint) Value() float64 {
+	for _, v := range p.Values {
+		return v
+	}
+	return 0
+}
+
+// unmarshalIndicatorPoint unmarshals a CSV record of a technical indicator,
+// whose first column is the time and whose other columns are values.
+func unmarshalIndicatorPoint(header, record []string) (IndicatorPoint, error) {
+	var t struct {
+		Timestamp marshaler.FlexibleTime `csv:"time"`
+	}
+	if err := csvext.UnmarshalRecord(header, record, &t); err != nil {
+		return IndicatorPoint{}, err
+	}
+	p := IndicatorPoint{Timestamp: t.Timestamp, Values: map[string]float64{}}
+	for i, name := range header {
+		if name == "time" || i >= len(record) {
+			continue
+		}
+		v, err := strconv.ParseFloat(record[i], 64)
+		if err != nil {
+			return IndicatorPoint{}, err
+		}
+		p.Values[name] = v
+	}
+	return p, nil
+}
+
+// An MAType is a type of moving average used by a technical indicator.
+type MAType int
+
+// Types of moving averages used by technical indicators.
+const (
+	MATypeSMA   MAType = iota // Simple moving average.
+	MATypeEMA                 // Exponential moving average.
+	MATypeWMA                 // Weighted moving average.
+	MATypeDEMA                // Double exponential moving average.
+	MATypeTEMA                // Triple exponential moving average.
+	MATypeTRIMA               // Triangular moving average.
+	MATypeT3                  // Tillson T3 moving average.
+	MATypeKAMA                // Kaufman adaptive moving average.
+	MATypeMAMA                // MESA adaptive moving average.
+)
+
+// indicatorQuery returns the query for a technical indicator function.
+func indicatorQuery(function, symbol string, interval Interval) url.Values {
+	return url.Values{
+		"function": []string{function},
+		"symbol":   []string{symbol},
+		"interval": []string{strings.ToLower(string(interval))},
+	}
+}
+
+// getIndicator gets technical indicator data, calling f for each data point.
+func (c *Client) getIndicator(ctx context.Context, query url.Values, f func(IndicatorPoint) error) error {
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
+		p, err := unmarshalIndicatorPoint(header, record)
+		if err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// setInt sets a parameter in a query unless n is zero, in which case Alpha
+// Vantage uses its default.
+func setInt(query url.Values, key string, n int) {
+	if n != 0 {
+		query.Set(key, strconv.Itoa(n))
+	}
+}
+
+// setString sets a parameter in a query unless s is empty, in which case
+// Alpha Vantage uses its default.
+func setString(query url.Values, key, s string) {
+	if s != "" {
+		query.Set(key, s)
+	}
+}
+
+// setFloat sets a parameter in a query unless x is zero, in which case Alpha
+// Vantage uses its default.
+func setFloat(query url.Values, key string, x float64) {
+	if x != 0 {
+		query.Set(key, strconv.FormatFloat(x, 'f', -1, 64))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+)
+
+// An InsiderTransaction is a transaction of a company's securities by one of
+// its key stakeholders, such as an executive or director.
+//
+// See: https://www.alphavantage.co/documentation/#insider-transactions
+type InsiderTransaction struct {
+	TransactionDate       NullDate    `json:"transaction_date"`
+	Ticker                string      `json:"ticker"`
+	Executive             string      `json:"executive"`
+	ExecutiveTitle        string      `json:"executive_title"`
+	SecurityType          string      `json:"security_type"`
+	AcquisitionOrDisposal string      `json:"acquisition_or_disposal"` // "A" or "D".
+	Shares                NullFloat64 `json:"shares"`
+	SharePrice            NullFloat64 `json:"share_price"`
+}
+
+// Acquisition reports whether the transaction was an acquisition rather than
+// a disposal.
+func (t InsiderTransaction) Acquisition() bool {
+	return t.AcquisitionOrDisposal == "A"
+}
+
+// GetInsiderTransactions returns the insider transactions of a company, latest
+// first.
+//
+// See: https://www.alphavantage.co/documentation/#insider-transactions
+func (c *Client) GetInsiderTransactions(symbol string) ([]InsiderTransaction, error) {
+	return c.GetInsiderTransactionsContext(context.Background(), symbol)
+}
+
+// GetInsiderTransactionsContext is like GetInsiderTransactions but uses ctx for
+// the request.
+func (c *Client) GetInsiderTransactionsContext(ctx context.Context, symbol string) ([]InsiderTransaction, error) {
+	var v struct {
+		Data []InsiderTransaction `json:"data"`
+	}
+	err := c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"INSIDER_TRANSACTIONS"},
+		"symbol":   []string{symbol},
+	}, &v)
+	return v.Data, err
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetInsiderTransactions(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"data": [
+				{
+					"transaction_date": "2024-10-01",
+					"ticker": "IBM",
+					"executive": "KAVANAUGH, JAMES J",
+					"executive_title": "SVP & CFO",
+					"security_type": "Common Stock",
+					"acquisition_or_disposal": "D",
+					"shares": "2000.0",
+					"share_price": "225.0"
+				}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetInsiderTransactions("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "function=INSIDER_TRANSACTIONS&symbol=IBM"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []InsiderTransaction{
+		InsiderTransaction{
+			TransactionDate:       NullDate{time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), true},
+			Ticker:                "IBM",
+			Executive:             "KAVANAUGH, JAMES J",
+			ExecutiveTitle:        "SVP & CFO",
+			SecurityType:          "Common Stock",
+			AcquisitionOrDisposal: "D",
+			Shares:                NullFloat64{2000, true},
+			SharePrice:            NullFloat64{225, true},
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	if got[0].Acquisition() {
+		t.Fatal("got acquisition, want disposal")
+	}
+}
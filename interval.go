@@ -29,6 +29,11 @@ const (
 	Interval1Day   Interval = "DAILY"
 	Interval1Week  Interval = "WEEKLY"
 	Interval1Month Interval = "MONTHLY"
+
+	// Quarterly and annual intervals are only supported by some endpoints,
+	// such as commodities.
+	Interval1Quarter Interval = "QUARTERLY"
+	Interval1Year    Interval = "ANNUAL"
 )
 
 // Duration returns the duration for the Interval.
@@ -50,6 +55,10 @@ func (i Interval) Duration() time.Duration {
 		return 7 * time.Hour * 24
 	case Interval1Month:
 		return 30 * time.Hour * 24
+	case Interval1Quarter:
+		return 91 * time.Hour * 24
+	case Interval1Year:
+		return 365 * time.Hour * 24
 	}
 	return 0
 }
@@ -0,0 +1,43 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_unsupportedInterval(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}), "")
+	for _, interval := range []Interval{Interval1Quarter, Interval1Year} {
+		if err := c.GetStockTimeSeries("MSFT", interval, OutputSizeCompact, func(StockQuote) error { return nil }); err == nil {
+			t.Errorf("GetStockTimeSeries(%q) succeeded, want error", interval)
+		}
+		if err := c.GetStockTimeSeriesAdjusted("MSFT", interval, OutputSizeCompact, func(StockQuoteAdjusted) error { return nil }); err == nil {
+			t.Errorf("GetStockTimeSeriesAdjusted(%q) succeeded, want error", interval)
+		}
+		if err := c.GetForexTimeSeries("EUR", "USD", interval, OutputSizeCompact, func(ForexQuote) error { return nil }); err == nil {
+			t.Errorf("GetForexTimeSeries(%q) succeeded, want error", interval)
+		}
+		if err := c.GetCryptoTimeSeries("BTC", "USD", interval, func(CryptoQuote) error { return nil }); err == nil {
+			t.Errorf("GetCryptoTimeSeries(%q) succeeded, want error", interval)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tradyfinance/csvext"
+)
+
+// An IPO is an expected initial public offering.
+//
+// See: https://www.alphavantage.co/documentation/#ipo-calendar
+type IPO struct {
+	Symbol         string      `csv:"symbol"`
+	Name           string      `csv:"name"`
+	IPODate        NullDate    `csv:"ipoDate"`
+	PriceRangeLow  NullFloat64 `csv:"priceRangeLow"`
+	PriceRangeHigh NullFloat64 `csv:"priceRangeHigh"`
+	Currency       string      `csv:"currency"`
+	Exchange       string      `csv:"exchange"`
+}
+
+// GetIPOCalendar gets the initial public offerings expected in the next three
+// months, calling f for each offering.
+//
+// See: https://www.alphavantage.co/documentation/#ipo-calendar
+func (c *Client) GetIPOCalendar(f func(IPO) error) error {
+	return c.GetIPOCalendarContext(context.Background(), f)
+}
+
+// GetIPOCalendarContext is like GetIPOCalendar but uses ctx for the request.
+func (c *Client) GetIPOCalendarContext(ctx context.Context, f func(IPO) error) error {
+	return c.getCSV(ctx, "/query", url.Values{
+		"function": []string{"IPO_CALENDAR"},
+	}, func(header, record []string) error {
+		var ipo IPO
+		if err := csvext.UnmarshalRecord(header, record, &ipo); err != nil {
+			return err
+		}
+		return f(ipo)
+	})
+}
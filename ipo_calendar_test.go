@@ -0,0 +1,68 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetIPOCalendar(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"symbol,name,ipoDate,priceRangeLow,priceRangeHigh,currency,exchange\n" +
+				"BOWN,Bowen Acquisition Corp - Units (1 Ord Share & 1 Rts),2023-07-12,10,10,USD,NASDAQ\n" +
+				"ATGL,Alpha Technology Group Ltd,2023-07-13,0,0,USD,NASDAQ\n",
+		))
+		return &res, nil
+	}), "")
+	got := []IPO{}
+	if err := c.GetIPOCalendar(func(ipo IPO) error {
+		got = append(got, ipo)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []IPO{
+		IPO{
+			Symbol:         "BOWN",
+			Name:           "Bowen Acquisition Corp - Units (1 Ord Share & 1 Rts)",
+			IPODate:        NullDate{time.Date(2023, 7, 12, 0, 0, 0, 0, time.UTC), true},
+			PriceRangeLow:  NullFloat64{10, true},
+			PriceRangeHigh: NullFloat64{10, true},
+			Currency:       "USD",
+			Exchange:       "NASDAQ",
+		},
+		IPO{
+			Symbol:         "ATGL",
+			Name:           "Alpha Technology Group Ltd",
+			IPODate:        NullDate{time.Date(2023, 7, 13, 0, 0, 0, 0, time.UTC), true},
+			PriceRangeLow:  NullFloat64{0, true},
+			PriceRangeHigh: NullFloat64{0, true},
+			Currency:       "USD",
+			Exchange:       "NASDAQ",
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
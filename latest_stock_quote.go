@@ -15,6 +15,7 @@
 package alphavantage
 
 import (
+	"context"
 	"io"
 	"net/url"
 
@@ -41,8 +42,14 @@ type LatestStockQuote struct {
 // GetLatestStockQuote returns the latest quote for a stock.
 //
 // See: https://www.alphavantage.co/documentation/#latestprice
-func (c *Client) GetLatestStockQuote(symbol string) (q LatestStockQuote, err error) {
-	err = c.getCSV("/query", url.Values{
+func (c *Client) GetLatestStockQuote(symbol string) (LatestStockQuote, error) {
+	return c.GetLatestStockQuoteContext(context.Background(), symbol)
+}
+
+// GetLatestStockQuoteContext is like GetLatestStockQuote but uses ctx for the
+// request.
+func (c *Client) GetLatestStockQuoteContext(ctx context.Context, symbol string) (q LatestStockQuote, err error) {
+	err = c.getCSV(ctx, "/query", url.Values{
 		"function": []string{"GLOBAL_QUOTE"},
 		"symbol":   []string{symbol},
 	}, func(header, record []string) error {
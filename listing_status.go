@@ -0,0 +1,70 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/tradyfinance/csvext"
+)
+
+// A ListingState is whether listings are active or delisted.
+type ListingState string
+
+// Listing states.
+const (
+	ListingStateActive   ListingState = "active"
+	ListingStateDelisted ListingState = "delisted"
+)
+
+// A Listing is an active or delisted listing of a security.
+//
+// See: https://www.alphavantage.co/documentation/#listing-status
+type Listing struct {
+	Symbol        string   `csv:"symbol"`
+	Name          string   `csv:"name"`
+	Exchange      string   `csv:"exchange"`
+	AssetType     string   `csv:"assetType"`
+	IPODate       NullDate `csv:"ipoDate"`
+	DelistingDate NullDate `csv:"delistingDate"`
+	Status        string   `csv:"status"`
+}
+
+// GetListingStatus gets the active or delisted listings as of a date, calling f
+// for each listing. The date may be left zero to get the latest listings.
+//
+// See: https://www.alphavantage.co/documentation/#listing-status
+func (c *Client) GetListingStatus(date time.Time, state ListingState, f func(Listing) error) error {
+	return c.GetListingStatusContext(context.Background(), date, state, f)
+}
+
+// GetListingStatusContext is like GetListingStatus but uses ctx for the
+// request.
+func (c *Client) GetListingStatusContext(ctx context.Context, date time.Time, state ListingState, f func(Listing) error) error {
+	query := url.Values{"function": []string{"LISTING_STATUS"}}
+	if !date.IsZero() {
+		query.Set("date", date.Format("2006-01-02"))
+	}
+	setString(query, "state", string(state))
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
+		var l Listing
+		if err := csvext.UnmarshalRecord(header, record, &l); err != nil {
+			return err
+		}
+		return f(l)
+	})
+}
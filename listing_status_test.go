@@ -0,0 +1,72 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetListingStatus(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"symbol,name,exchange,assetType,ipoDate,delistingDate,status\n" +
+				"AAIC,Arlington Asset Investment Corp,NYSE,Stock,1997-12-23,2013-05-21,Delisted\n" +
+				"AAC-U,Ares Acquisition Corp - Units (1 Ord Share Class A & 1/5 War),NYSE,Stock,2021-02-02,null,Delisted\n",
+		))
+		return &res, nil
+	}), "")
+	got := []Listing{}
+	if err := c.GetListingStatus(time.Date(2014, 7, 10, 0, 0, 0, 0, time.UTC), ListingStateDelisted, func(l Listing) error {
+		got = append(got, l)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&date=2014-07-10&function=LISTING_STATUS&state=delisted"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []Listing{
+		Listing{
+			Symbol:        "AAIC",
+			Name:          "Arlington Asset Investment Corp",
+			Exchange:      "NYSE",
+			AssetType:     "Stock",
+			IPODate:       NullDate{time.Date(1997, 12, 23, 0, 0, 0, 0, time.UTC), true},
+			DelistingDate: NullDate{time.Date(2013, 5, 21, 0, 0, 0, 0, time.UTC), true},
+			Status:        "Delisted",
+		},
+		Listing{
+			Symbol:    "AAC-U",
+			Name:      "Ares Acquisition Corp - Units (1 Ord Share Class A & 1/5 War)",
+			Exchange:  "NYSE",
+			AssetType: "Stock",
+			IPODate:   NullDate{time.Date(2021, 2, 2, 0, 0, 0, 0, time.UTC), true},
+			Status:    "Delisted",
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// regionTimeZones are the time zones of the markets in each region reported by
+// Alpha Vantage.
+var regionTimeZones = map[string]string{
+	"United States":  "America/New_York",
+	"Canada":         "America/Toronto",
+	"United Kingdom": "Europe/London",
+	"Germany":        "Europe/Berlin",
+	"France":         "Europe/Paris",
+	"Spain":          "Europe/Madrid",
+	"Portugal":       "Europe/Lisbon",
+	"Japan":          "Asia/Tokyo",
+	"India":          "Asia/Kolkata",
+	"Mainland China": "Asia/Shanghai",
+	"Hong Kong":      "Asia/Hong_Kong",
+	"Brazil":         "America/Sao_Paulo",
+	"Mexico":         "America/Mexico_City",
+	"South Africa":   "Africa/Johannesburg",
+	"Global":         "UTC",
+}
+
+// A Market is the current status of a major trading venue.
+//
+// See: https://www.alphavantage.co/documentation/#market-status
+type Market struct {
+	MarketType       string
+	Region           string
+	PrimaryExchanges []string
+
+	// Location is the time zone of the market, and LocalOpen and LocalClose
+	// are its opening and closing times today in that time zone. They are
+	// left nil and zero for regions with an unknown time zone, and the times
+	// are left zero if malformed.
+	Location   *time.Location
+	LocalOpen  time.Time
+	LocalClose time.Time
+
+	CurrentStatus string // "open" or "closed".
+	Notes         string
+}
+
+// Open reports whether the market is currently open.
+func (m Market) Open() bool {
+	return strings.EqualFold(m.CurrentStatus, "open")
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *Market) UnmarshalJSON(b []byte) error {
+	var v struct {
+		MarketType       string `json:"market_type"`
+		Region           string `json:"region"`
+		PrimaryExchanges string `json:"primary_exchanges"`
+		LocalOpen        string `json:"local_open"`
+		LocalClose       string `json:"local_close"`
+		CurrentStatus    string `json:"current_status"`
+		Notes            string `json:"notes"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*m = Market{
+		MarketType:    v.MarketType,
+		Region:        v.Region,
+		CurrentStatus: v.CurrentStatus,
+		Notes:         v.Notes,
+	}
+	for _, exchange := range strings.Split(v.PrimaryExchanges, ",") {
+		if exchange = strings.TrimSpace(exchange); exchange != "" {
+			m.PrimaryExchanges = append(m.PrimaryExchanges, exchange)
+		}
+	}
+	name, ok := regionTimeZones[v.Region]
+	if !ok {
+		return nil
+	}
+	if m.Location = loadLocation(name); m.Location == nil {
+		return nil
+	}
+
+	// Malformed times are left zero rather than discarding the market.
+	today := timeNow().In(m.Location)
+	m.LocalOpen, _ = parseLocalTime(v.LocalOpen, today)
+	m.LocalClose, _ = parseLocalTime(v.LocalClose, today)
+	return nil
+}
+
+// parseLocalTime parses a time of day such as "09:30" on the date of day, in
+// its location.
+func parseLocalTime(s string, day time.Time) (time.Time, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, day.Location()), nil
+}
+
+// GetMarketStatus returns the current status of major trading venues for
+// equities, forex and cryptocurrencies around the world.
+//
+// See: https://www.alphavantage.co/documentation/#market-status
+func (c *Client) GetMarketStatus() ([]Market, error) {
+	return c.GetMarketStatusContext(context.Background())
+}
+
+// GetMarketStatusContext is like GetMarketStatus but uses ctx for the request.
+func (c *Client) GetMarketStatusContext(ctx context.Context) ([]Market, error) {
+	var v struct {
+		Markets []Market `json:"markets"`
+	}
+	err := c.getJSON(ctx, "/query", url.Values{
+		"function": []string{"MARKET_STATUS"},
+	}, &v)
+	return v.Markets, err
+}
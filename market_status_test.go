@@ -0,0 +1,98 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetMarketStatus(t *testing.T) {
+	if _, err := time.LoadLocation("America/New_York"); err != nil {
+		t.Skip(err)
+	}
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+	timeNow = func() time.Time { return time.Date(2023, 11, 17, 3, 0, 0, 0, time.UTC) }
+
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"endpoint": "Global Market Open & Close Status",
+			"markets": [
+				{
+					"market_type": "Equity",
+					"region": "United States",
+					"primary_exchanges": "NASDAQ, NYSE, AMEX, BATS",
+					"local_open": "09:30",
+					"local_close": "16:15",
+					"current_status": "open",
+					"notes": ""
+				},
+				{
+					"market_type": "Equity",
+					"region": "Atlantis",
+					"primary_exchanges": "ATX",
+					"local_open": "10:00",
+					"local_close": "15:00",
+					"current_status": "closed",
+					"notes": ""
+				}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetMarketStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "function=MARKET_STATUS"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d markets, want 2", len(got))
+	}
+
+	us := got[0]
+	if us.MarketType != "Equity" || us.Region != "United States" || !us.Open() {
+		t.Fatalf("got market %+v", us)
+	}
+	if want := []string{"NASDAQ", "NYSE", "AMEX", "BATS"}; !reflect.DeepEqual(us.PrimaryExchanges, want) {
+		t.Fatalf("got primary exchanges %v, want %v", us.PrimaryExchanges, want)
+	}
+	if want := "America/New_York"; us.Location.String() != want {
+		t.Fatalf("got location %v, want %v", us.Location, want)
+	}
+	// 03:00 UTC is still the previous day in New York.
+	if want := time.Date(2023, 11, 16, 9, 30, 0, 0, us.Location); !us.LocalOpen.Equal(want) || us.LocalOpen.Location() != us.Location {
+		t.Fatalf("got local open %v, want %v", us.LocalOpen, want)
+	}
+	if want := time.Date(2023, 11, 16, 16, 15, 0, 0, us.Location); !us.LocalClose.Equal(want) {
+		t.Fatalf("got local close %v, want %v", us.LocalClose, want)
+	}
+
+	unknown := got[1]
+	if unknown.Open() || unknown.Location != nil || !unknown.LocalOpen.IsZero() {
+		t.Fatalf("got market %+v", unknown)
+	}
+}
@@ -0,0 +1,810 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tradyfinance/csvext"
+	"github.com/tradyfinance/marshaler"
+)
+
+// A StochPoint is a data point of the stochastic oscillator (STOCH).
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type StochPoint struct {
+	Timestamp marshaler.FlexibleTime `csv:"time"`
+	SlowK     float64                `csv:"SlowK"`
+	SlowD     float64                `csv:"SlowD"`
+}
+
+// A StochFPoint is a data point of the stochastic fast (STOCHF) or stochastic
+// relative strength index (STOCHRSI) oscillators.
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type StochFPoint struct {
+	Timestamp marshaler.FlexibleTime `csv:"time"`
+	FastK     float64                `csv:"FastK"`
+	FastD     float64                `csv:"FastD"`
+}
+
+// An AroonPoint is a data point of the Aroon indicator (AROON).
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type AroonPoint struct {
+	Timestamp marshaler.FlexibleTime `csv:"time"`
+	AroonDown float64                `csv:"Aroon Down"`
+	AroonUp   float64                `csv:"Aroon Up"`
+}
+
+// A MACDPoint is a data point of the moving average convergence/divergence
+// indicators (MACD and MACDEXT).
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type MACDPoint struct {
+	Timestamp marshaler.FlexibleTime `csv:"time"`
+	MACD      float64                `csv:"MACD"`
+	Signal    float64                `csv:"MACD_Signal"`
+	Hist      float64                `csv:"MACD_Hist"`
+}
+
+// An RSIRequest is a request for relative strength index (RSI) data.
+//
+// See: https://www.alphavantage.co/documentation/#rsi
+type RSIRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+}
+
+func (r RSIRequest) query() url.Values {
+	query := indicatorQuery("RSI", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetRSI gets relative strength index (RSI) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#rsi
+func (c *Client) GetRSI(req RSIRequest, f func(IndicatorPoint) error) error {
+	return c.GetRSIContext(context.Background(), req, f)
+}
+
+// GetRSIContext is like GetRSI but uses ctx for the request.
+func (c *Client) GetRSIContext(ctx context.Context, req RSIRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A StochRequest is a request for stochastic oscillator (STOCH) data. Optional
+// parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#stoch
+type StochRequest struct {
+	Symbol      string
+	Interval    Interval
+	FastKPeriod int    // Optional fast k time period.
+	SlowKPeriod int    // Optional slow k time period.
+	SlowDPeriod int    // Optional slow d time period.
+	SlowKMAType MAType // Optional slow k moving average type.
+	SlowDMAType MAType // Optional slow d moving average type.
+}
+
+func (r StochRequest) query() url.Values {
+	query := indicatorQuery("STOCH", r.Symbol, r.Interval)
+	setInt(query, "fastkperiod", r.FastKPeriod)
+	setInt(query, "slowkperiod", r.SlowKPeriod)
+	setInt(query, "slowdperiod", r.SlowDPeriod)
+	setInt(query, "slowkmatype", int(r.SlowKMAType))
+	setInt(query, "slowdmatype", int(r.SlowDMAType))
+	return query
+}
+
+// GetStoch gets stochastic oscillator (STOCH) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#stoch
+func (c *Client) GetStoch(req StochRequest, f func(StochPoint) error) error {
+	return c.GetStochContext(context.Background(), req, f)
+}
+
+// GetStochContext is like GetStoch but uses ctx for the request.
+func (c *Client) GetStochContext(ctx context.Context, req StochRequest, f func(StochPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p StochPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// A StochFRequest is a request for stochastic fast (STOCHF) data. Optional
+// parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#stochf
+type StochFRequest struct {
+	Symbol      string
+	Interval    Interval
+	FastKPeriod int    // Optional fast k time period.
+	FastDPeriod int    // Optional fast d time period.
+	FastDMAType MAType // Optional fast d moving average type.
+}
+
+func (r StochFRequest) query() url.Values {
+	query := indicatorQuery("STOCHF", r.Symbol, r.Interval)
+	setInt(query, "fastkperiod", r.FastKPeriod)
+	setInt(query, "fastdperiod", r.FastDPeriod)
+	setInt(query, "fastdmatype", int(r.FastDMAType))
+	return query
+}
+
+// GetStochF gets stochastic fast (STOCHF) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#stochf
+func (c *Client) GetStochF(req StochFRequest, f func(StochFPoint) error) error {
+	return c.GetStochFContext(context.Background(), req, f)
+}
+
+// GetStochFContext is like GetStochF but uses ctx for the request.
+func (c *Client) GetStochFContext(ctx context.Context, req StochFRequest, f func(StochFPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p StochFPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// A StochRSIRequest is a request for stochastic relative strength index
+// (STOCHRSI) data. Optional parameters left zero use the Alpha Vantage
+// defaults.
+//
+// See: https://www.alphavantage.co/documentation/#stochrsi
+type StochRSIRequest struct {
+	Symbol      string
+	Interval    Interval
+	TimePeriod  int // Number of data points per value.
+	SeriesType  SeriesType
+	FastKPeriod int    // Optional fast k time period.
+	FastDPeriod int    // Optional fast d time period.
+	FastDMAType MAType // Optional fast d moving average type.
+}
+
+func (r StochRSIRequest) query() url.Values {
+	query := indicatorQuery("STOCHRSI", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	setInt(query, "fastkperiod", r.FastKPeriod)
+	setInt(query, "fastdperiod", r.FastDPeriod)
+	setInt(query, "fastdmatype", int(r.FastDMAType))
+	return query
+}
+
+// GetStochRSI gets stochastic relative strength index (STOCHRSI) data, calling
+// f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#stochrsi
+func (c *Client) GetStochRSI(req StochRSIRequest, f func(StochFPoint) error) error {
+	return c.GetStochRSIContext(context.Background(), req, f)
+}
+
+// GetStochRSIContext is like GetStochRSI but uses ctx for the request.
+func (c *Client) GetStochRSIContext(ctx context.Context, req StochRSIRequest, f func(StochFPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p StochFPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// A WillRRequest is a request for Williams' %R (WILLR) data.
+//
+// See: https://www.alphavantage.co/documentation/#willr
+type WillRRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r WillRRequest) query() url.Values {
+	query := indicatorQuery("WILLR", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetWillR gets Williams' %R (WILLR) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#willr
+func (c *Client) GetWillR(req WillRRequest, f func(IndicatorPoint) error) error {
+	return c.GetWillRContext(context.Background(), req, f)
+}
+
+// GetWillRContext is like GetWillR but uses ctx for the request.
+func (c *Client) GetWillRContext(ctx context.Context, req WillRRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An ADXRequest is a request for average directional movement index (ADX) data.
+//
+// See: https://www.alphavantage.co/documentation/#adx
+type ADXRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r ADXRequest) query() url.Values {
+	query := indicatorQuery("ADX", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetADX gets average directional movement index (ADX) data, calling f for each
+// data point.
+//
+// See: https://www.alphavantage.co/documentation/#adx
+func (c *Client) GetADX(req ADXRequest, f func(IndicatorPoint) error) error {
+	return c.GetADXContext(context.Background(), req, f)
+}
+
+// GetADXContext is like GetADX but uses ctx for the request.
+func (c *Client) GetADXContext(ctx context.Context, req ADXRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An ADXRRequest is a request for average directional movement index rating
+// (ADXR) data.
+//
+// See: https://www.alphavantage.co/documentation/#adxr
+type ADXRRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r ADXRRequest) query() url.Values {
+	query := indicatorQuery("ADXR", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetADXR gets average directional movement index rating (ADXR) data, calling f
+// for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#adxr
+func (c *Client) GetADXR(req ADXRRequest, f func(IndicatorPoint) error) error {
+	return c.GetADXRContext(context.Background(), req, f)
+}
+
+// GetADXRContext is like GetADXR but uses ctx for the request.
+func (c *Client) GetADXRContext(ctx context.Context, req ADXRRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An APORequest is a request for absolute price oscillator (APO) data. Optional
+// parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#apo
+type APORequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+	FastPeriod int    // Optional fast time period.
+	SlowPeriod int    // Optional slow time period.
+	MAType     MAType // Optional moving average type.
+}
+
+func (r APORequest) query() url.Values {
+	query := indicatorQuery("APO", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	setInt(query, "fastperiod", r.FastPeriod)
+	setInt(query, "slowperiod", r.SlowPeriod)
+	setInt(query, "matype", int(r.MAType))
+	return query
+}
+
+// GetAPO gets absolute price oscillator (APO) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#apo
+func (c *Client) GetAPO(req APORequest, f func(IndicatorPoint) error) error {
+	return c.GetAPOContext(context.Background(), req, f)
+}
+
+// GetAPOContext is like GetAPO but uses ctx for the request.
+func (c *Client) GetAPOContext(ctx context.Context, req APORequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A PPORequest is a request for percentage price oscillator (PPO) data.
+// Optional parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#ppo
+type PPORequest struct {
+	Symbol     string
+	Interval   Interval
+	SeriesType SeriesType
+	FastPeriod int    // Optional fast time period.
+	SlowPeriod int    // Optional slow time period.
+	MAType     MAType // Optional moving average type.
+}
+
+func (r PPORequest) query() url.Values {
+	query := indicatorQuery("PPO", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	setInt(query, "fastperiod", r.FastPeriod)
+	setInt(query, "slowperiod", r.SlowPeriod)
+	setInt(query, "matype", int(r.MAType))
+	return query
+}
+
+// GetPPO gets percentage price oscillator (PPO) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#ppo
+func (c *Client) GetPPO(req PPORequest, f func(IndicatorPoint) error) error {
+	return c.GetPPOContext(context.Background(), req, f)
+}
+
+// GetPPOContext is like GetPPO but uses ctx for the request.
+func (c *Client) GetPPOContext(ctx context.Context, req PPORequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An MOMRequest is a request for momentum (MOM) data.
+//
+// See: https://www.alphavantage.co/documentation/#mom
+type MOMRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+}
+
+func (r MOMRequest) query() url.Values {
+	query := indicatorQuery("MOM", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetMOM gets momentum (MOM) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#mom
+func (c *Client) GetMOM(req MOMRequest, f func(IndicatorPoint) error) error {
+	return c.GetMOMContext(context.Background(), req, f)
+}
+
+// GetMOMContext is like GetMOM but uses ctx for the request.
+func (c *Client) GetMOMContext(ctx context.Context, req MOMRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A BOPRequest is a request for balance of power (BOP) data.
+//
+// See: https://www.alphavantage.co/documentation/#bop
+type BOPRequest struct {
+	Symbol   string
+	Interval Interval
+}
+
+func (r BOPRequest) query() url.Values {
+	query := indicatorQuery("BOP", r.Symbol, r.Interval)
+	return query
+}
+
+// GetBOP gets balance of power (BOP) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#bop
+func (c *Client) GetBOP(req BOPRequest, f func(IndicatorPoint) error) error {
+	return c.GetBOPContext(context.Background(), req, f)
+}
+
+// GetBOPContext is like GetBOP but uses ctx for the request.
+func (c *Client) GetBOPContext(ctx context.Context, req BOPRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A CCIRequest is a request for commodity channel index (CCI) data.
+//
+// See: https://www.alphavantage.co/documentation/#cci
+type CCIRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r CCIRequest) query() url.Values {
+	query := indicatorQuery("CCI", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetCCI gets commodity channel index (CCI) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#cci
+func (c *Client) GetCCI(req CCIRequest, f func(IndicatorPoint) error) error {
+	return c.GetCCIContext(context.Background(), req, f)
+}
+
+// GetCCIContext is like GetCCI but uses ctx for the request.
+func (c *Client) GetCCIContext(ctx context.Context, req CCIRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A CMORequest is a request for Chande momentum oscillator (CMO) data.
+//
+// See: https://www.alphavantage.co/documentation/#cmo
+type CMORequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+}
+
+func (r CMORequest) query() url.Values {
+	query := indicatorQuery("CMO", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetCMO gets Chande momentum oscillator (CMO) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#cmo
+func (c *Client) GetCMO(req CMORequest, f func(IndicatorPoint) error) error {
+	return c.GetCMOContext(context.Background(), req, f)
+}
+
+// GetCMOContext is like GetCMO but uses ctx for the request.
+func (c *Client) GetCMOContext(ctx context.Context, req CMORequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An ROCRequest is a request for rate of change (ROC) data.
+//
+// See: https://www.alphavantage.co/documentation/#roc
+type ROCRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+}
+
+func (r ROCRequest) query() url.Values {
+	query := indicatorQuery("ROC", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetROC gets rate of change (ROC) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#roc
+func (c *Client) GetROC(req ROCRequest, f func(IndicatorPoint) error) error {
+	return c.GetROCContext(context.Background(), req, f)
+}
+
+// GetROCContext is like GetROC but uses ctx for the request.
+func (c *Client) GetROCContext(ctx context.Context, req ROCRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An ROCRRequest is a request for rate of change ratio (ROCR) data.
+//
+// See: https://www.alphavantage.co/documentation/#rocr
+type ROCRRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+}
+
+func (r ROCRRequest) query() url.Values {
+	query := indicatorQuery("ROCR", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetROCR gets rate of change ratio (ROCR) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#rocr
+func (c *Client) GetROCR(req ROCRRequest, f func(IndicatorPoint) error) error {
+	return c.GetROCRContext(context.Background(), req, f)
+}
+
+// GetROCRContext is like GetROCR but uses ctx for the request.
+func (c *Client) GetROCRContext(ctx context.Context, req ROCRRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An AroonRequest is a request for Aroon indicator (AROON) data.
+//
+// See: https://www.alphavantage.co/documentation/#aroon
+type AroonRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r AroonRequest) query() url.Values {
+	query := indicatorQuery("AROON", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetAroon gets Aroon indicator (AROON) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#aroon
+func (c *Client) GetAroon(req AroonRequest, f func(AroonPoint) error) error {
+	return c.GetAroonContext(context.Background(), req, f)
+}
+
+// GetAroonContext is like GetAroon but uses ctx for the request.
+func (c *Client) GetAroonContext(ctx context.Context, req AroonRequest, f func(AroonPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p AroonPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// An AroonOscRequest is a request for Aroon oscillator (AROONOSC) data.
+//
+// See: https://www.alphavantage.co/documentation/#aroonosc
+type AroonOscRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r AroonOscRequest) query() url.Values {
+	query := indicatorQuery("AROONOSC", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetAroonOsc gets Aroon oscillator (AROONOSC) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#aroonosc
+func (c *Client) GetAroonOsc(req AroonOscRequest, f func(IndicatorPoint) error) error {
+	return c.GetAroonOscContext(context.Background(), req, f)
+}
+
+// GetAroonOscContext is like GetAroonOsc but uses ctx for the request.
+func (c *Client) GetAroonOscContext(ctx context.Context, req AroonOscRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An MFIRequest is a request for money flow index (MFI) data.
+//
+// See: https://www.alphavantage.co/documentation/#mfi
+type MFIRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r MFIRequest) query() url.Values {
+	query := indicatorQuery("MFI", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetMFI gets money flow index (MFI) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#mfi
+func (c *Client) GetMFI(req MFIRequest, f func(IndicatorPoint) error) error {
+	return c.GetMFIContext(context.Background(), req, f)
+}
+
+// GetMFIContext is like GetMFI but uses ctx for the request.
+func (c *Client) GetMFIContext(ctx context.Context, req MFIRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A TRIXRequest is a request for 1-day rate of change of a triple smooth
+// exponential moving average (TRIX) data.
+//
+// See: https://www.alphavantage.co/documentation/#trix
+type TRIXRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+}
+
+func (r TRIXRequest) query() url.Values {
+	query := indicatorQuery("TRIX", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetTRIX gets 1-day rate of change of a triple smooth exponential moving
+// average (TRIX) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#trix
+func (c *Client) GetTRIX(req TRIXRequest, f func(IndicatorPoint) error) error {
+	return c.GetTRIXContext(context.Background(), req, f)
+}
+
+// GetTRIXContext is like GetTRIX but uses ctx for the request.
+func (c *Client) GetTRIXContext(ctx context.Context, req TRIXRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An UltOscRequest is a request for ultimate oscillator (ULTOSC) data. Optional
+// parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#ultosc
+type UltOscRequest struct {
+	Symbol      string
+	Interval    Interval
+	TimePeriod1 int // Optional first time period.
+	TimePeriod2 int // Optional second time period.
+	TimePeriod3 int // Optional third time period.
+}
+
+func (r UltOscRequest) query() url.Values {
+	query := indicatorQuery("ULTOSC", r.Symbol, r.Interval)
+	setInt(query, "timeperiod1", r.TimePeriod1)
+	setInt(query, "timeperiod2", r.TimePeriod2)
+	setInt(query, "timeperiod3", r.TimePeriod3)
+	return query
+}
+
+// GetUltOsc gets ultimate oscillator (ULTOSC) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#ultosc
+func (c *Client) GetUltOsc(req UltOscRequest, f func(IndicatorPoint) error) error {
+	return c.GetUltOscContext(context.Background(), req, f)
+}
+
+// GetUltOscContext is like GetUltOsc but uses ctx for the request.
+func (c *Client) GetUltOscContext(ctx context.Context, req UltOscRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A DXRequest is a request for directional movement index (DX) data.
+//
+// See: https://www.alphavantage.co/documentation/#dx
+type DXRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r DXRequest) query() url.Values {
+	query := indicatorQuery("DX", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetDX gets directional movement index (DX) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#dx
+func (c *Client) GetDX(req DXRequest, f func(IndicatorPoint) error) error {
+	return c.GetDXContext(context.Background(), req, f)
+}
+
+// GetDXContext is like GetDX but uses ctx for the request.
+func (c *Client) GetDXContext(ctx context.Context, req DXRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A MACDRequest is a request for moving average convergence/divergence (MACD)
+// data. Optional parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#macd
+type MACDRequest struct {
+	Symbol       string
+	Interval     Interval
+	SeriesType   SeriesType
+	FastPeriod   int // Optional fast time period.
+	SlowPeriod   int // Optional slow time period.
+	SignalPeriod int // Optional signal time period.
+}
+
+func (r MACDRequest) query() url.Values {
+	query := indicatorQuery("MACD", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	setInt(query, "fastperiod", r.FastPeriod)
+	setInt(query, "slowperiod", r.SlowPeriod)
+	setInt(query, "signalperiod", r.SignalPeriod)
+	return query
+}
+
+// GetMACD gets moving average convergence/divergence (MACD) data, calling f for
+// each data point.
+//
+// See: https://www.alphavantage.co/documentation/#macd
+func (c *Client) GetMACD(req MACDRequest, f func(MACDPoint) error) error {
+	return c.GetMACDContext(context.Background(), req, f)
+}
+
+// GetMACDContext is like GetMACD but uses ctx for the request.
+func (c *Client) GetMACDContext(ctx context.Context, req MACDRequest, f func(MACDPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p MACDPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// A MACDExtRequest is a request for moving average convergence/divergence with
+// controllable moving average types (MACDEXT) data. Optional parameters left
+// zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#macdext
+type MACDExtRequest struct {
+	Symbol       string
+	Interval     Interval
+	SeriesType   SeriesType
+	FastPeriod   int    // Optional fast time period.
+	SlowPeriod   int    // Optional slow time period.
+	SignalPeriod int    // Optional signal time period.
+	FastMAType   MAType // Optional fast moving average type.
+	SlowMAType   MAType // Optional slow moving average type.
+	SignalMAType MAType // Optional signal moving average type.
+}
+
+func (r MACDExtRequest) query() url.Values {
+	query := indicatorQuery("MACDEXT", r.Symbol, r.Interval)
+	setString(query, "series_type", string(r.SeriesType))
+	setInt(query, "fastperiod", r.FastPeriod)
+	setInt(query, "slowperiod", r.SlowPeriod)
+	setInt(query, "signalperiod", r.SignalPeriod)
+	setInt(query, "fastmatype", int(r.FastMAType))
+	setInt(query, "slowmatype", int(r.SlowMAType))
+	setInt(query, "signalmatype", int(r.SignalMAType))
+	return query
+}
+
+// GetMACDExt gets moving average convergence/divergence with controllable
+// moving average types (MACDEXT) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#macdext
+func (c *Client) GetMACDExt(req MACDExtRequest, f func(MACDPoint) error) error {
+	return c.GetMACDExtContext(context.Background(), req, f)
+}
+
+// GetMACDExtContext is like GetMACDExt but uses ctx for the request.
+func (c *Client) GetMACDExtContext(ctx context.Context, req MACDExtRequest, f func(MACDPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p MACDPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
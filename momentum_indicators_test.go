@@ -0,0 +1,243 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetMACDExt(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"time,MACD,MACD_Hist,MACD_Signal\n" +
+				"2019-09-17,0.5373,0.1648,0.3725\n" +
+				"2019-09-16,0.3920,0.0618,0.3302\n",
+		))
+		return &res, nil
+	}), "")
+	got := []MACDPoint{}
+	if err := c.GetMACDExt(MACDExtRequest{
+		Symbol:     "MSFT",
+		Interval:   Interval1Week,
+		SeriesType: SeriesTypeClose,
+		FastMAType: MATypeEMA,
+	}, func(p MACDPoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&fastmatype=1&function=MACDEXT&interval=weekly&series_type=close&symbol=MSFT"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []MACDPoint{
+		MACDPoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)),
+			MACD:      0.5373,
+			Signal:    0.3725,
+			Hist:      0.1648,
+		},
+		MACDPoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 16, 0, 0, 0, 0, time.UTC)),
+			MACD:      0.3920,
+			Signal:    0.3302,
+			Hist:      0.0618,
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_GetRSI(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"time,RSI\n" +
+				"2019-09-17 16:00:00,61.3360\n",
+		))
+		return &res, nil
+	}), "")
+	got := []IndicatorPoint{}
+	if err := c.GetRSI(RSIRequest{
+		Symbol:     "MSFT",
+		Interval:   Interval15Min,
+		TimePeriod: 14,
+		SeriesType: SeriesTypeClose,
+	}, func(p IndicatorPoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&function=RSI&interval=15min&series_type=close&symbol=MSFT&time_period=14"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []IndicatorPoint{
+		IndicatorPoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 17, 16, 0, 0, 0, time.UTC)),
+			Values:    map[string]float64{"RSI": 61.3360},
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_momentumIndicatorPoints(t *testing.T) {
+	ts := marshaler.FlexibleTime(time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC))
+	for _, tt := range []struct {
+		name  string
+		body  string
+		get   func(c *Client) (interface{}, error)
+		query string
+		want  interface{}
+	}{
+		{
+			name: "STOCH",
+			body: "time,SlowK,SlowD\n2019-09-17,87.1306,84.2957\n",
+			get: func(c *Client) (interface{}, error) {
+				var got []StochPoint
+				err := c.GetStoch(StochRequest{
+					Symbol:      "MSFT",
+					Interval:    Interval1Day,
+					FastKPeriod: 5,
+					SlowKPeriod: 3,
+					SlowDPeriod: 3,
+					SlowKMAType: MATypeEMA,
+					SlowDMAType: MATypeEMA,
+				}, func(p StochPoint) error {
+					got = append(got, p)
+					return nil
+				})
+				return got, err
+			},
+			query: "datatype=csv&fastkperiod=5&function=STOCH&interval=daily&slowdmatype=1&slowdperiod=3&slowkmatype=1&slowkperiod=3&symbol=MSFT",
+			want:  []StochPoint{{Timestamp: ts, SlowK: 87.1306, SlowD: 84.2957}},
+		},
+		{
+			name: "STOCHF",
+			body: "time,FastK,FastD\n2019-09-17,92.6136,87.1306\n",
+			get: func(c *Client) (interface{}, error) {
+				var got []StochFPoint
+				err := c.GetStochF(StochFRequest{
+					Symbol:      "MSFT",
+					Interval:    Interval1Day,
+					FastKPeriod: 5,
+					FastDPeriod: 3,
+					FastDMAType: MATypeEMA,
+				}, func(p StochFPoint) error {
+					got = append(got, p)
+					return nil
+				})
+				return got, err
+			},
+			query: "datatype=csv&fastdmatype=1&fastdperiod=3&fastkperiod=5&function=STOCHF&interval=daily&symbol=MSFT",
+			want:  []StochFPoint{{Timestamp: ts, FastK: 92.6136, FastD: 87.1306}},
+		},
+		{
+			name: "STOCHRSI",
+			body: "time,FastK,FastD\n2019-09-17,100.0000,78.4302\n",
+			get: func(c *Client) (interface{}, error) {
+				var got []StochFPoint
+				err := c.GetStochRSI(StochRSIRequest{
+					Symbol:      "MSFT",
+					Interval:    Interval1Week,
+					TimePeriod:  14,
+					SeriesType:  SeriesTypeClose,
+					FastKPeriod: 5,
+					FastDPeriod: 3,
+				}, func(p StochFPoint) error {
+					got = append(got, p)
+					return nil
+				})
+				return got, err
+			},
+			query: "datatype=csv&fastdperiod=3&fastkperiod=5&function=STOCHRSI&interval=weekly&series_type=close&symbol=MSFT&time_period=14",
+			want:  []StochFPoint{{Timestamp: ts, FastK: 100, FastD: 78.4302}},
+		},
+		{
+			name: "AROON",
+			body: "time,Aroon Down,Aroon Up\n2019-09-17,14.2857,85.7143\n",
+			get: func(c *Client) (interface{}, error) {
+				var got []AroonPoint
+				err := c.GetAroon(AroonRequest{
+					Symbol:     "MSFT",
+					Interval:   Interval1Day,
+					TimePeriod: 14,
+				}, func(p AroonPoint) error {
+					got = append(got, p)
+					return nil
+				})
+				return got, err
+			},
+			query: "datatype=csv&function=AROON&interval=daily&symbol=MSFT&time_period=14",
+			want:  []AroonPoint{{Timestamp: ts, AroonDown: 14.2857, AroonUp: 85.7143}},
+		},
+		{
+			name: "MACD",
+			body: "time,MACD,MACD_Hist,MACD_Signal\n2019-09-17,0.5373,0.1648,0.3725\n",
+			get: func(c *Client) (interface{}, error) {
+				var got []MACDPoint
+				err := c.GetMACD(MACDRequest{
+					Symbol:       "MSFT",
+					Interval:     Interval1Day,
+					SeriesType:   SeriesTypeClose,
+					FastPeriod:   12,
+					SlowPeriod:   26,
+					SignalPeriod: 9,
+				}, func(p MACDPoint) error {
+					got = append(got, p)
+					return nil
+				})
+				return got, err
+			},
+			query: "datatype=csv&fastperiod=12&function=MACD&interval=daily&series_type=close&signalperiod=9&slowperiod=26&symbol=MSFT",
+			want:  []MACDPoint{{Timestamp: ts, MACD: 0.5373, Signal: 0.3725, Hist: 0.1648}},
+		},
+	} {
+		var query string
+		c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			var res http.Response
+			res.StatusCode = http.StatusOK
+			res.Body = ioutil.NopCloser(strings.NewReader(tt.body))
+			return &res, nil
+		}), "")
+		got, err := tt.get(c)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if query != tt.query {
+			t.Errorf("%s: got query %q, want %q", tt.name, query, tt.query)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"strconv"
+)
+
+// getMovingAverage gets moving average data for a function that takes a time
+// period and series type, calling f for each data point.
+func (c *Client) getMovingAverage(ctx context.Context, function, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	query := indicatorQuery(function, symbol, interval)
+	query.Set("time_period", strconv.Itoa(timePeriod))
+	query.Set("series_type", string(seriesType))
+	return c.getIndicator(ctx, query, f)
+}
+
+// GetSMA gets simple moving average (SMA) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#sma
+func (c *Client) GetSMA(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetSMAContext(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetSMAContext is like GetSMA but uses ctx for the request.
+func (c *Client) GetSMAContext(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "SMA", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetEMA gets exponential moving average (EMA) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#ema
+func (c *Client) GetEMA(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetEMAContext(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetEMAContext is like GetEMA but uses ctx for the request.
+func (c *Client) GetEMAContext(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "EMA", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetWMA gets weighted moving average (WMA) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#wma
+func (c *Client) GetWMA(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetWMAContext(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetWMAContext is like GetWMA but uses ctx for the request.
+func (c *Client) GetWMAContext(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "WMA", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetDEMA gets double exponential moving average (DEMA) data, calling f for
+// each data point.
+//
+// See: https://www.alphavantage.co/documentation/#dema
+func (c *Client) GetDEMA(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetDEMAContext(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetDEMAContext is like GetDEMA but uses ctx for the request.
+func (c *Client) GetDEMAContext(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "DEMA", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetTEMA gets triple exponential moving average (TEMA) data, calling f for
+// each data point.
+//
+// See: https://www.alphavantage.co/documentation/#tema
+func (c *Client) GetTEMA(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetTEMAContext(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetTEMAContext is like GetTEMA but uses ctx for the request.
+func (c *Client) GetTEMAContext(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "TEMA", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetTRIMA gets triangular moving average (TRIMA) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#trima
+func (c *Client) GetTRIMA(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetTRIMAContext(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetTRIMAContext is like GetTRIMA but uses ctx for the request.
+func (c *Client) GetTRIMAContext(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "TRIMA", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetKAMA gets Kaufman adaptive moving average (KAMA) data, calling f for each
+// data point.
+//
+// See: https://www.alphavantage.co/documentation/#kama
+func (c *Client) GetKAMA(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetKAMAContext(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetKAMAContext is like GetKAMA but uses ctx for the request.
+func (c *Client) GetKAMAContext(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "KAMA", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetT3 gets Tillson T3 moving average (T3) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#t3
+func (c *Client) GetT3(symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.GetT3Context(context.Background(), symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetT3Context is like GetT3 but uses ctx for the request.
+func (c *Client) GetT3Context(ctx context.Context, symbol string, interval Interval, timePeriod int, seriesType SeriesType, f func(IndicatorPoint) error) error {
+	return c.getMovingAverage(ctx, "T3", symbol, interval, timePeriod, seriesType, f)
+}
+
+// GetMAMA gets MESA adaptive moving average (MAMA) data, calling f for each
+// data point. Each data point has a "MAMA" and a "FAMA" value. The fast and
+// slow limits are typically 0.5 and 0.05.
+//
+// See: https://www.alphavantage.co/documentation/#mama
+func (c *Client) GetMAMA(symbol string, interval Interval, seriesType SeriesType, fastLimit, slowLimit float64, f func(IndicatorPoint) error) error {
+	return c.GetMAMAContext(context.Background(), symbol, interval, seriesType, fastLimit, slowLimit, f)
+}
+
+// GetMAMAContext is like GetMAMA but uses ctx for the request.
+func (c *Client) GetMAMAContext(ctx context.Context, symbol string, interval Interval, seriesType SeriesType, fastLimit, slowLimit float64, f func(IndicatorPoint) error) error {
+	query := indicatorQuery("MAMA", symbol, interval)
+	query.Set("series_type", string(seriesType))
+	query.Set("fastlimit", strconv.FormatFloat(fastLimit, 'f', -1, 64))
+	query.Set("slowlimit", strconv.FormatFloat(slowLimit, 'f', -1, 64))
+	return c.getIndicator(ctx, query, f)
+}
+
+// GetVWAP gets volume weighted average price (VWAP) data, calling f for each
+// data point. Only intraday intervals are supported.
+//
+// See: https://www.alphavantage.co/documentation/#vwap
+func (c *Client) GetVWAP(symbol string, interval Interval, f func(IndicatorPoint) error) error {
+	return c.GetVWAPContext(context.Background(), symbol, interval, f)
+}
+
+// GetVWAPContext is like GetVWAP but uses ctx for the request.
+func (c *Client) GetVWAPContext(ctx context.Context, symbol string, interval Interval, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, indicatorQuery("VWAP", symbol, interval), f)
+}
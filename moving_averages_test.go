@@ -0,0 +1,94 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetSMA(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"time,SMA\n" +
+				"2019-09-17,136.3980\n" +
+				"2019-09-16,135.9650\n",
+		))
+		return &res, nil
+	}), "")
+	got := []IndicatorPoint{}
+	if err := c.GetSMA("MSFT", Interval1Day, 10, SeriesTypeClose, func(p IndicatorPoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&function=SMA&interval=daily&series_type=close&symbol=MSFT&time_period=10"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []IndicatorPoint{
+		IndicatorPoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)),
+			Values:    map[string]float64{"SMA": 136.3980},
+		},
+		IndicatorPoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 16, 0, 0, 0, 0, time.UTC)),
+			Values:    map[string]float64{"SMA": 135.9650},
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	if got[0].Value() != 136.3980 {
+		t.Fatalf("got value %v, want %v", got[0].Value(), 136.3980)
+	}
+}
+
+func TestClient_GetMAMA(t *testing.T) {
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"time,FAMA,MAMA\n" +
+				"2019-09-17 16:00:00,135.7012,137.0425\n",
+		))
+		return &res, nil
+	}), "")
+	got := []IndicatorPoint{}
+	if err := c.GetMAMA("MSFT", Interval60Min, SeriesTypeClose, 0.5, 0.05, func(p IndicatorPoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []IndicatorPoint{
+		IndicatorPoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 17, 16, 0, 0, 0, time.UTC)),
+			Values:    map[string]float64{"FAMA": 135.7012, "MAMA": 137.0425},
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
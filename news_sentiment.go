@@ -0,0 +1,173 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A NewsTopic is a topic of news articles.
+type NewsTopic string
+
+// Topics of news articles.
+const (
+	NewsTopicBlockchain             NewsTopic = "blockchain"
+	NewsTopicEarnings               NewsTopic = "earnings"
+	NewsTopicIPO                    NewsTopic = "ipo"
+	NewsTopicMergersAndAcquisitions NewsTopic = "mergers_and_acquisitions"
+	NewsTopicFinancialMarkets       NewsTopic = "financial_markets"
+	NewsTopicEconomyFiscal          NewsTopic = "economy_fiscal"
+	NewsTopicEconomyMonetary        NewsTopic = "economy_monetary"
+	NewsTopicEconomyMacro           NewsTopic = "economy_macro"
+	NewsTopicEnergyTransportation   NewsTopic = "energy_transportation"
+	NewsTopicFinance                NewsTopic = "finance"
+	NewsTopicLifeSciences           NewsTopic = "life_sciences"
+	NewsTopicManufacturing          NewsTopic = "manufacturing"
+	NewsTopicRealEstate             NewsTopic = "real_estate"
+	NewsTopicRetailWholesale        NewsTopic = "retail_wholesale"
+	NewsTopicTechnology             NewsTopic = "technology"
+)
+
+// A NewsSort is the order of news articles.
+type NewsSort string
+
+// Orders of news articles.
+const (
+	NewsSortLatest    NewsSort = "LATEST"
+	NewsSortEarliest  NewsSort = "EARLIEST"
+	NewsSortRelevance NewsSort = "RELEVANCE"
+)
+
+// A NewsQuery is a query for news articles. Every field is optional.
+//
+// See: https://www.alphavantage.co/documentation/#news-sentiment
+type NewsQuery struct {
+	Tickers  []string    // Tickers mentioned, e.g. "CRYPTO:BTC".
+	Topics   []NewsTopic // Topics covered.
+	TimeFrom time.Time   // Earliest publishing time.
+	TimeTo   time.Time   // Latest publishing time.
+	Sort     NewsSort
+	Limit    int // Maximum number of articles.
+}
+
+// values returns the query parameters for the NewsQuery.
+func (q NewsQuery) values() url.Values {
+	query := url.Values{"function": []string{"NEWS_SENTIMENT"}}
+	setString(query, "tickers", strings.Join(q.Tickers, ","))
+	topics := make([]string, len(q.Topics))
+	for i, topic := range q.Topics {
+		topics[i] = string(topic)
+	}
+	setString(query, "topics", strings.Join(topics, ","))
+	if !q.TimeFrom.IsZero() {
+		query.Set("time_from", q.TimeFrom.UTC().Format("20060102T1504"))
+	}
+	if !q.TimeTo.IsZero() {
+		query.Set("time_to", q.TimeTo.UTC().Format("20060102T1504"))
+	}
+	setString(query, "sort", string(q.Sort))
+	if q.Limit > 0 {
+		query.Set("limit", strconv.Itoa(q.Limit))
+	}
+	return query
+}
+
+// A NewsTopicRelevance is the relevance of a news article to a topic.
+type NewsTopicRelevance struct {
+	Topic          string  `json:"topic"`
+	RelevanceScore float64 `json:"relevance_score,string"`
+}
+
+// A TickerSentiment is the relevance of a news article to a ticker and its
+// sentiment towards it.
+type TickerSentiment struct {
+	Ticker               string  `json:"ticker"`
+	RelevanceScore       float64 `json:"relevance_score,string"`
+	TickerSentimentScore float64 `json:"ticker_sentiment_score,string"`
+	TickerSentimentLabel string  `json:"ticker_sentiment_label"`
+}
+
+// A NewsTime is a UTC time in the compact layout Alpha Vantage uses for news,
+// e.g. "20240102T013000".
+type NewsTime time.Time
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t NewsTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(t).UTC().Format("20060102T150405"))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *NewsTime) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *NewsTime) UnmarshalText(b []byte) error {
+	x, err := time.Parse("20060102T150405", string(b))
+	if err != nil {
+		return err
+	}
+	*t = NewsTime(x)
+	return nil
+}
+
+// A NewsArticle is a news article with sentiment scores. Sentiment scores
+// range from -1 (bearish) to 1 (bullish), and relevance scores from 0 to 1.
+//
+// See: https://www.alphavantage.co/documentation/#news-sentiment
+type NewsArticle struct {
+	Title                 string               `json:"title"`
+	URL                   string               `json:"url"`
+	TimePublished         NewsTime             `json:"time_published"`
+	Authors               []string             `json:"authors"`
+	Summary               string               `json:"summary"`
+	BannerImage           string               `json:"banner_image"`
+	Source                string               `json:"source"`
+	CategoryWithinSource  string               `json:"category_within_source"`
+	SourceDomain          string               `json:"source_domain"`
+	Topics                []NewsTopicRelevance `json:"topics"`
+	OverallSentimentScore float64              `json:"overall_sentiment_score"`
+	OverallSentimentLabel string               `json:"overall_sentiment_label"`
+	TickerSentiment       []TickerSentiment    `json:"ticker_sentiment"`
+}
+
+// GetNewsSentiment returns the news articles matching a query, with their
+// sentiment scores.
+//
+// See: https://www.alphavantage.co/documentation/#news-sentiment
+func (c *Client) GetNewsSentiment(query NewsQuery) ([]NewsArticle, error) {
+	return c.GetNewsSentimentContext(context.Background(), query)
+}
+
+// GetNewsSentimentContext is like GetNewsSentiment but uses ctx for the
+// request.
+func (c *Client) GetNewsSentimentContext(ctx context.Context, query NewsQuery) ([]NewsArticle, error) {
+	var v struct {
+		Feed []NewsArticle `json:"feed"`
+	}
+	if err := c.getJSON(ctx, "/query", query.values(), &v); err != nil {
+		return nil, err
+	}
+	return v.Feed, nil
+}
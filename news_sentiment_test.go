@@ -0,0 +1,100 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetNewsSentiment(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"items": "1",
+			"sentiment_score_definition": "x <= -0.35: Bearish; -0.35 < x <= -0.15: Somewhat-Bearish; -0.15 < x < 0.15: Neutral; 0.15 <= x < 0.35: Somewhat_Bullish; x >= 0.35: Bullish",
+			"relevance_score_definition": "0 < x <= 1, with a higher score indicating higher relevance.",
+			"feed": [
+				{
+					"title": "Apple Stock Gains",
+					"url": "https://www.example.com/apple-stock-gains",
+					"time_published": "20240102T013000",
+					"authors": ["Jane Doe"],
+					"summary": "Apple shares rose.",
+					"banner_image": "",
+					"source": "Example News",
+					"category_within_source": "n/a",
+					"source_domain": "www.example.com",
+					"topics": [
+						{"topic": "Technology", "relevance_score": "1.0"}
+					],
+					"overall_sentiment_score": 0.254181,
+					"overall_sentiment_label": "Somewhat-Bullish",
+					"ticker_sentiment": [
+						{
+							"ticker": "AAPL",
+							"relevance_score": "0.920031",
+							"ticker_sentiment_score": "0.365129",
+							"ticker_sentiment_label": "Bullish"
+						}
+					]
+				}
+			]
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetNewsSentiment(NewsQuery{
+		Tickers:  []string{"AAPL", "CRYPTO:BTC"},
+		Topics:   []NewsTopic{NewsTopicTechnology},
+		TimeFrom: time.Date(2024, 1, 1, 1, 30, 0, 0, time.UTC),
+		Sort:     NewsSortLatest,
+		Limit:    50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "function=NEWS_SENTIMENT&limit=50&sort=LATEST&tickers=AAPL%2CCRYPTO%3ABTC&time_from=20240101T0130&topics=technology"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []NewsArticle{
+		NewsArticle{
+			Title:                 "Apple Stock Gains",
+			URL:                   "https://www.example.com/apple-stock-gains",
+			TimePublished:         NewsTime(time.Date(2024, 1, 2, 1, 30, 0, 0, time.UTC)),
+			Authors:               []string{"Jane Doe"},
+			Summary:               "Apple shares rose.",
+			Source:                "Example News",
+			CategoryWithinSource:  "n/a",
+			SourceDomain:          "www.example.com",
+			Topics:                []NewsTopicRelevance{{"Technology", 1.0}},
+			OverallSentimentScore: 0.254181,
+			OverallSentimentLabel: "Somewhat-Bullish",
+			TickerSentiment: []TickerSentiment{
+				{"AAPL", 0.920031, 0.365129, "Bullish"},
+			},
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
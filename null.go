@@ -0,0 +1,147 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isNull reports whether s is one of the placeholders Alpha Vantage uses for
+// missing values.
+func isNull(s string) bool {
+	switch strings.TrimSpace(s) {
+	case "", "None", "none", "null", "-", ".", "N/A":
+		return true
+	}
+	return false
+}
+
+// unquote returns the contents of a JSON string, or the JSON text itself for
+// other values such as numbers.
+func unquote(b []byte) string {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return s
+	}
+	return string(b)
+}
+
+// A NullFloat64 is a float64 that may be missing, e.g. when Alpha Vantage
+// reports "None" or "-".
+type NullFloat64 struct {
+	Float64 float64
+	Valid   bool // Valid is true if Float64 is not missing.
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullFloat64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Float64)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullFloat64) UnmarshalJSON(b []byte) error {
+	return n.UnmarshalText([]byte(unquote(b)))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *NullFloat64) UnmarshalText(b []byte) error {
+	s := string(b)
+	if isNull(s) {
+		*n = NullFloat64{}
+		return nil
+	}
+	x, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return err
+	}
+	*n = NullFloat64{x, true}
+	return nil
+}
+
+// A NullInt64 is an int64 that may be missing, e.g. when Alpha Vantage reports
+// "None" or "-".
+type NullInt64 struct {
+	Int64 int64
+	Valid bool // Valid is true if Int64 is not missing.
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullInt64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Int64)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullInt64) UnmarshalJSON(b []byte) error {
+	return n.UnmarshalText([]byte(unquote(b)))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *NullInt64) UnmarshalText(b []byte) error {
+	s := string(b)
+	if isNull(s) {
+		*n = NullInt64{}
+		return nil
+	}
+	x, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return err
+	}
+	*n = NullInt64{x, true}
+	return nil
+}
+
+// A NullDate is a date that may be missing, e.g. when Alpha Vantage reports
+// "None", "null" or "0000-00-00".
+type NullDate struct {
+	Time  time.Time
+	Valid bool // Valid is true if Time is not missing.
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Time.Format("2006-01-02"))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullDate) UnmarshalJSON(b []byte) error {
+	return n.UnmarshalText([]byte(unquote(b)))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *NullDate) UnmarshalText(b []byte) error {
+	s := string(b)
+	if isNull(s) || s == "0000-00-00" {
+		*n = NullDate{}
+		return nil
+	}
+	t, err := time.Parse("2006-01-02", strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*n = NullDate{t, true}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// An Option configures a Client.
+type Option func(*Client)
+
+// NewClientWithOptions returns a new Client configured by opts. Without any
+// options, it is equivalent to NewClient(nil, "").
+func NewClientWithOptions(opts ...Option) *Client {
+	c := NewClient(nil, "")
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithHTTPClient sets the HTTP client. It defaults to http.DefaultClient when
+// nil.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		if hc == nil {
+			hc = http.DefaultClient
+		}
+		c.client = hc
+	}
+}
+
+// WithAPIKey sets the API key.
+func WithAPIKey(apiKey string) Option {
+	return func(c *Client) { c.APIKey = apiKey }
+}
+
+// WithBaseURL sets the base URL for the API, which may include a path prefix.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.BaseURL = baseURL }
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) { c.UserAgent = userAgent }
+}
+
+// WithRateLimiter sets the rate limiter that paces every request.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *Client) { c.Limiter = l }
+}
+
+// WithRetryPolicy sets the policy for retrying failed requests.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) { c.RetryPolicy = &p }
+}
+
+// WithHooks sets the functions called around every HTTP request.
+func WithHooks(h Hooks) Option {
+	return func(c *Client) { c.Hooks = h }
+}
+
+// WithCache sets the cache for responses.
+func WithCache(cache Cache) Option {
+	return func(c *Client) { c.Cache = cache }
+}
+
+// WithCacheTTL sets the function that determines how long a response may be
+// cached given the path and query of its request.
+func WithCacheTTL(ttl func(path string, query url.Values) time.Duration) Option {
+	return func(c *Client) { c.CacheTTL = ttl }
+}
+
+// WithRequestCoalescing makes concurrent identical requests share a single HTTP
+// request.
+func WithRequestCoalescing() Option {
+	return func(c *Client) { c.CoalesceRequests = true }
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	var got *http.Request
+	hc := httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("currency code,currency name\n"))
+		return &res, nil
+	})
+	after := 0
+	c := NewClientWithOptions(
+		WithHTTPClient(hc),
+		WithAPIKey("demo"),
+		WithBaseURL("http://localhost:8080"),
+		WithUserAgent("test/1.0"),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2}),
+		WithHooks(Hooks{
+			BeforeRequest: func(req *http.Request) { got = req },
+			AfterResponse: func(*http.Request, *http.Response, error) { after++ },
+		}),
+	)
+	if err := c.GetDigitalCurrencies(func(Currency) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || after != 1 {
+		t.Fatalf("hooks were not called")
+	}
+	if want := "http://localhost:8080/digital_currency_list/?apikey=demo&datatype=csv"; got.URL.String() != want {
+		t.Fatalf("got URL %q, want %q", got.URL, want)
+	}
+	if want := "test/1.0"; got.Header.Get("User-Agent") != want {
+		t.Fatalf("got User-Agent %q, want %q", got.Header.Get("User-Agent"), want)
+	}
+	if c.RetryPolicy == nil || c.RetryPolicy.MaxAttempts != 2 {
+		t.Fatalf("got retry policy %+v", c.RetryPolicy)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tradyfinance/csvext"
+	"github.com/tradyfinance/marshaler"
+)
+
+// An OptionType is the type of an option contract.
+type OptionType string
+
+// Types of option contracts.
+const (
+	OptionTypeCall OptionType = "call"
+	OptionTypePut  OptionType = "put"
+)
+
+// An OptionContract is a quote of a US option contract. The greeks are only
+// valid when requested.
+//
+// See: https://www.alphavantage.co/documentation/#options-data-api
+type OptionContract struct {
+	ContractID        string         `csv:"contractID"`
+	Symbol            string         `csv:"symbol"`
+	Expiration        marshaler.Date `csv:"expiration"`
+	Strike            float64        `csv:"strike"`
+	Type              OptionType     `csv:"type"`
+	Last              float64        `csv:"last"`
+	Mark              float64        `csv:"mark"`
+	Bid               float64        `csv:"bid"`
+	BidSize           int64          `csv:"bid_size"`
+	Ask               float64        `csv:"ask"`
+	AskSize           int64          `csv:"ask_size"`
+	Volume            int64          `csv:"volume"`
+	OpenInterest      int64          `csv:"open_interest"`
+	Date              marshaler.Date `csv:"date"`
+	ImpliedVolatility NullFloat64    `csv:"implied_volatility"`
+	Delta             NullFloat64    `csv:"delta"`
+	Gamma             NullFloat64    `csv:"gamma"`
+	Theta             NullFloat64    `csv:"theta"`
+	Vega              NullFloat64    `csv:"vega"`
+	Rho               NullFloat64    `csv:"rho"`
+}
+
+// GetRealtimeOptions gets the realtime option chain of a symbol, calling f for
+// each contract. The greeks and implied volatility are only included when
+// requireGreeks is true.
+//
+// See: https://www.alphavantage.co/documentation/#realtime-options
+func (c *Client) GetRealtimeOptions(symbol string, requireGreeks bool, f func(OptionContract) error) error {
+	return c.GetRealtimeOptionsContext(context.Background(), symbol, requireGreeks, f)
+}
+
+// GetRealtimeOptionsContext is like GetRealtimeOptions but uses ctx for the
+// request.
+func (c *Client) GetRealtimeOptionsContext(ctx context.Context, symbol string, requireGreeks bool, f func(OptionContract) error) error {
+	return c.getOptions(ctx, url.Values{
+		"function":       []string{"REALTIME_OPTIONS"},
+		"symbol":         []string{symbol},
+		"require_greeks": []string{strconv.FormatBool(requireGreeks)},
+	}, f)
+}
+
+// GetHistoricalOptions gets the option chain of a symbol as of a date, calling
+// f for each contract. The date may be left zero to get the chain as of the
+// previous trading session.
+//
+// See: https://www.alphavantage.co/documentation/#historical-options
+func (c *Client) GetHistoricalOptions(symbol string, date time.Time, f func(OptionContract) error) error {
+	return c.GetHistoricalOptionsContext(context.Background(), symbol, date, f)
+}
+
+// GetHistoricalOptionsContext is like GetHistoricalOptions but uses ctx for the
+// request.
+func (c *Client) GetHistoricalOptionsContext(ctx context.Context, symbol string, date time.Time, f func(OptionContract) error) error {
+	query := url.Values{
+		"function": []string{"HISTORICAL_OPTIONS"},
+		"symbol":   []string{symbol},
+	}
+	if !date.IsZero() {
+		query.Set("date", date.Format("2006-01-02"))
+	}
+	return c.getOptions(ctx, query, f)
+}
+
+func (c *Client) getOptions(ctx context.Context, query url.Values, f func(OptionContract) error) error {
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
+		var oc OptionContract
+		if err := csvext.UnmarshalRecord(header, record, &oc); err != nil {
+			return err
+		}
+		return f(oc)
+	})
+}
+
+// An OptionChain is a list of option contracts.
+type OptionChain []OptionContract
+
+// Expirations returns the distinct expiration dates of the chain in ascending
+// order.
+func (c OptionChain) Expirations() []time.Time {
+	seen := make(map[time.Time]bool)
+	var expirations []time.Time
+	for _, oc := range c {
+		t := time.Time(oc.Expiration)
+		if !seen[t] {
+			seen[t] = true
+			expirations = append(expirations, t)
+		}
+	}
+	sort.Slice(expirations, func(i, j int) bool {
+		return expirations[i].Before(expirations[j])
+	})
+	return expirations
+}
+
+// ByExpiration groups the contracts of the chain by expiration date,
+// preserving their order.
+func (c OptionChain) ByExpiration() map[time.Time]OptionChain {
+	m := make(map[time.Time]OptionChain)
+	for _, oc := range c {
+		t := time.Time(oc.Expiration)
+		m[t] = append(m[t], oc)
+	}
+	return m
+}
+
+// An OptionStrike is the call and put at a strike price. Either may be nil
+// when the chain has no such contract.
+type OptionStrike struct {
+	Strike float64
+	Call   *OptionContract
+	Put    *OptionContract
+}
+
+// Strikes pairs the calls and puts of the chain by strike price, in ascending
+// order. The chain should be of a single expiration, e.g. as grouped by
+// ByExpiration.
+func (c OptionChain) Strikes() []OptionStrike {
+	index := make(map[float64]int)
+	var strikes []OptionStrike
+	for i := range c {
+		oc := &c[i]
+		j, ok := index[oc.Strike]
+		if !ok {
+			j = len(strikes)
+			index[oc.Strike] = j
+			strikes = append(strikes, OptionStrike{Strike: oc.Strike})
+		}
+		switch oc.Type {
+		case OptionTypeCall:
+			strikes[j].Call = oc
+		case OptionTypePut:
+			strikes[j].Put = oc
+		}
+	}
+	sort.Slice(strikes, func(i, j int) bool {
+		return strikes[i].Strike < strikes[j].Strike
+	})
+	return strikes
+}
@@ -0,0 +1,142 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+const optionsCSV = "contractID,symbol,expiration,strike,type,last,mark,bid,bid_size,ask,ask_size,volume,open_interest,date,implied_volatility,delta,gamma,theta,vega,rho\n" +
+	"IBM231215C00140000,IBM,2023-12-15,140.00,call,5.50,5.45,5.40,20,5.50,30,120,1500,2023-11-17,0.18,0.62,0.05,-0.06,0.12,0.03\n" +
+	"IBM231215P00140000,IBM,2023-12-15,140.00,put,1.30,1.32,1.30,15,1.34,10,80,900,2023-11-17,0.19,-0.38,0.05,-0.04,0.12,-0.02\n" +
+	"IBM231215C00135000,IBM,2023-12-15,135.00,call,9.10,9.05,9.00,5,9.10,8,12,300,2023-11-17,0.21,0.81,0.03,-0.05,0.08,0.04\n" +
+	"IBM231222C00140000,IBM,2023-12-22,140.00,call,6.10,6.05,6.00,7,6.10,9,40,200,2023-11-17,0.17,0.60,0.04,-0.05,0.14,0.04\n"
+
+func TestClient_GetHistoricalOptions(t *testing.T) {
+	var query url.Values
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(optionsCSV))
+		return &res, nil
+	}), "")
+	var chain OptionChain
+	if err := c.GetHistoricalOptions("IBM", time.Date(2023, 11, 17, 0, 0, 0, 0, time.UTC), func(oc OptionContract) error {
+		chain = append(chain, oc)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (url.Values{
+		"function": []string{"HISTORICAL_OPTIONS"},
+		"symbol":   []string{"IBM"},
+		"date":     []string{"2023-11-17"},
+		"datatype": []string{"csv"},
+	}); !reflect.DeepEqual(query, want) {
+		t.Fatalf("got query %v, want %v", query, want)
+	}
+	if len(chain) != 4 {
+		t.Fatalf("got %d contracts, want 4", len(chain))
+	}
+	if want := (OptionContract{
+		ContractID:        "IBM231215P00140000",
+		Symbol:            "IBM",
+		Expiration:        marshaler.Date(time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC)),
+		Strike:            140,
+		Type:              OptionTypePut,
+		Last:              1.30,
+		Mark:              1.32,
+		Bid:               1.30,
+		BidSize:           15,
+		Ask:               1.34,
+		AskSize:           10,
+		Volume:            80,
+		OpenInterest:      900,
+		Date:              marshaler.Date(time.Date(2023, 11, 17, 0, 0, 0, 0, time.UTC)),
+		ImpliedVolatility: NullFloat64{0.19, true},
+		Delta:             NullFloat64{-0.38, true},
+		Gamma:             NullFloat64{0.05, true},
+		Theta:             NullFloat64{-0.04, true},
+		Vega:              NullFloat64{0.12, true},
+		Rho:               NullFloat64{-0.02, true},
+	}); chain[1] != want {
+		t.Fatalf("got %+v, want %+v", chain[1], want)
+	}
+}
+
+func TestClient_GetRealtimeOptions(t *testing.T) {
+	var query url.Values
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"contractID,symbol,expiration,strike,type,last,mark,bid,bid_size,ask,ask_size,volume,open_interest,date\n" +
+				"IBM231215C00140000,IBM,2023-12-15,140.00,call,5.50,5.45,5.40,20,5.50,30,120,1500,2023-11-17\n",
+		))
+		return &res, nil
+	}), "")
+	var got []OptionContract
+	if err := c.GetRealtimeOptions("IBM", false, func(oc OptionContract) error {
+		got = append(got, oc)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "false"; query.Get("require_greeks") != want {
+		t.Fatalf("got require_greeks %q, want %q", query.Get("require_greeks"), want)
+	}
+	if len(got) != 1 || got[0].ContractID != "IBM231215C00140000" || got[0].Delta.Valid {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestOptionChain(t *testing.T) {
+	dec15 := time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC)
+	dec22 := time.Date(2023, 12, 22, 0, 0, 0, 0, time.UTC)
+	chain := OptionChain{
+		{ContractID: "C140-22", Expiration: marshaler.Date(dec22), Strike: 140, Type: OptionTypeCall},
+		{ContractID: "C140-15", Expiration: marshaler.Date(dec15), Strike: 140, Type: OptionTypeCall},
+		{ContractID: "P140-15", Expiration: marshaler.Date(dec15), Strike: 140, Type: OptionTypePut},
+		{ContractID: "C135-15", Expiration: marshaler.Date(dec15), Strike: 135, Type: OptionTypeCall},
+	}
+	if got, want := chain.Expirations(), []time.Time{dec15, dec22}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got expirations %v, want %v", got, want)
+	}
+	byExpiration := chain.ByExpiration()
+	if len(byExpiration) != 2 || len(byExpiration[dec15]) != 3 || len(byExpiration[dec22]) != 1 {
+		t.Fatalf("got %+v", byExpiration)
+	}
+	strikes := byExpiration[dec15].Strikes()
+	if len(strikes) != 2 {
+		t.Fatalf("got %d strikes, want 2", len(strikes))
+	}
+	if s := strikes[0]; s.Strike != 135 || s.Call == nil || s.Call.ContractID != "C135-15" || s.Put != nil {
+		t.Fatalf("got strike %+v", s)
+	}
+	if s := strikes[1]; s.Strike != 140 || s.Call == nil || s.Call.ContractID != "C140-15" || s.Put == nil || s.Put.ContractID != "P140-15" {
+		t.Fatalf("got strike %+v", s)
+	}
+}
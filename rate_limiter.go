@@ -0,0 +1,161 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Request budgets of the free Alpha Vantage plan.
+//
+// See: https://www.alphavantage.co/premium/
+const (
+	FreePlanRequestsPerMinute = 5
+	FreePlanRequestsPerDay    = 25
+)
+
+// A RateLimiter paces requests to stay within a per-minute and a per-day
+// budget. Each budget is a token bucket that holds up to the budget's number of
+// requests and refills continuously over its period. A RateLimiter is safe for
+// concurrent use by multiple goroutines.
+type RateLimiter struct {
+	mu     sync.Mutex
+	minute bucket
+	day    bucket
+	now    func() time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter given a per-minute and a per-day
+// budget. A budget less than or equal to zero is unlimited.
+func NewRateLimiter(perMinute, perDay int) *RateLimiter {
+	l := &RateLimiter{now: time.Now}
+	t := l.now()
+	l.minute = newBucket(perMinute, time.Minute, t)
+	l.day = newBucket(perDay, 24*time.Hour, t)
+	return l
+}
+
+// NewFreePlanRateLimiter returns a new RateLimiter for the budgets of the free
+// Alpha Vantage plan.
+func NewFreePlanRateLimiter() *RateLimiter {
+	return NewRateLimiter(FreePlanRequestsPerMinute, FreePlanRequestsPerDay)
+}
+
+// Wait blocks until a request may be made within both budgets, or until ctx is
+// done. A nil RateLimiter never blocks.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	for {
+		l.mu.Lock()
+		t := l.now()
+		l.minute.refill(t)
+		l.day.refill(t)
+		d := l.minute.delay()
+		if dd := l.day.delay(); dd > d {
+			d = dd
+		}
+		if d <= 0 {
+			l.minute.take()
+			l.day.take()
+			l.mu.Unlock()
+			return nil
+		}
+		l.mu.Unlock()
+
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// RemainingMinute returns the number of requests that may be made immediately
+// within the per-minute budget, or -1 when it is unlimited.
+func (l *RateLimiter) RemainingMinute() int {
+	if l == nil {
+		return -1
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.minute.refill(l.now())
+	return l.minute.remaining()
+}
+
+// RemainingDaily returns the number of requests that may be made immediately
+// within the per-day budget, or -1 when it is unlimited.
+func (l *RateLimiter) RemainingDaily() int {
+	if l == nil {
+		return -1
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.day.refill(l.now())
+	return l.day.remaining()
+}
+
+// A bucket is a token bucket. A bucket with zero capacity is unlimited.
+type bucket struct {
+	capacity float64
+	tokens   float64
+	period   time.Duration
+	last     time.Time
+}
+
+func newBucket(n int, period time.Duration, t time.Time) bucket {
+	if n <= 0 {
+		return bucket{}
+	}
+	return bucket{capacity: float64(n), tokens: float64(n), period: period, last: t}
+}
+
+func (b *bucket) refill(t time.Time) {
+	if b.capacity == 0 || !t.After(b.last) {
+		return
+	}
+	b.tokens += float64(t.Sub(b.last)) / float64(b.period) * b.capacity
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = t
+}
+
+// delay returns how long until a token is available.
+func (b *bucket) delay() time.Duration {
+	if b.capacity == 0 || b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - b.tokens) / b.capacity * float64(b.period)))
+}
+
+func (b *bucket) take() {
+	if b.capacity != 0 {
+		b.tokens--
+	}
+}
+
+func (b *bucket) remaining() int {
+	if b.capacity == 0 {
+		return -1
+	}
+	return int(b.tokens)
+}
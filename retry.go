@@ -0,0 +1,112 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+// A RetryPolicy determines how failed requests are retried. Requests made by
+// streaming methods are only retried while no record has been delivered to the
+// callback.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry. It doubles after each
+	// retry up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomized.
+	Jitter float64
+
+	// Retryable reports whether an error is retryable. It defaults to
+	// IsRetryable when nil.
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy is a reasonable retry policy for the free Alpha Vantage
+// plan.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  15 * time.Second,
+	MaxBackoff:  time.Minute,
+	Jitter:      0.2,
+}
+
+// IsRetryable reports whether err is a rate limit error, a server error
+// (5xx status) or a network timeout.
+func IsRetryable(err error) bool {
+	if errors.Is(err, ErrRateLimitExceeded) {
+		return true
+	}
+	var statusErr httpext.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// backoff returns the delay before the nth retry, starting at 1.
+func (p *RetryPolicy) backoff(n int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < n && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+	return d
+}
+
+// retry calls f until it succeeds, fails with an error that is not retryable,
+// reports that records were delivered, or the attempts are exhausted.
+func (c *Client) retry(ctx context.Context, f func() (delivered bool, err error)) error {
+	p := c.RetryPolicy
+	for n := 1; ; n++ {
+		delivered, err := f()
+		if err == nil || delivered || p == nil || n >= p.MaxAttempts || ctx.Err() != nil {
+			return err
+		}
+		retryable := p.Retryable
+		if retryable == nil {
+			retryable = IsRetryable
+		}
+		if !retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(p.backoff(n))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_retry(t *testing.T) {
+	n := 0
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		n++
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		switch n {
+		case 1:
+			res.StatusCode = http.StatusBadGateway
+			res.Body = ioutil.NopCloser(strings.NewReader(""))
+		case 2:
+			res.Header = http.Header{"Content-Type": []string{"application/json"}}
+			res.Body = ioutil.NopCloser(strings.NewReader(`{"Note": "Our standard API call frequency is 5 calls per minute."}`))
+		default:
+			res.Body = ioutil.NopCloser(strings.NewReader("currency code,currency name\nBTC,Bitcoin\n"))
+		}
+		return &res, nil
+	}), "")
+	c.RetryPolicy = &RetryPolicy{MaxAttempts: 3}
+	got := []Currency{}
+	if err := c.GetDigitalCurrencies(func(c Currency) error {
+		got = append(got, c)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || len(got) != 1 {
+		t.Fatalf("got %d requests and %d currencies, want 3 and 1", n, len(got))
+	}
+}
+
+func TestClient_retryDelivered(t *testing.T) {
+	n := 0
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		n++
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("currency code,currency name\nBTC,Bitcoin\nETH,Ethereum\n"))
+		return &res, nil
+	}), "")
+	c.RetryPolicy = &RetryPolicy{MaxAttempts: 3}
+	if err := c.GetDigitalCurrencies(func(c Currency) error {
+		if c.Code == "ETH" {
+			return ErrRateLimitExceeded
+		}
+		return nil
+	}); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("got error %v, want %v", err, ErrRateLimitExceeded)
+	}
+	if n != 1 {
+		t.Fatalf("got %d requests, want 1", n)
+	}
+}
@@ -15,6 +15,7 @@
 package alphavantage
 
 import (
+	"context"
 	"net/url"
 
 	"github.com/tradyfinance/csvext"
@@ -39,7 +40,12 @@ type SearchResult struct {
 //
 // See: https://www.alphavantage.co/documentation/#symbolsearch
 func (c *Client) Search(keywords string, f func(SearchResult) error) error {
-	return c.getCSV("/query", url.Values{
+	return c.SearchContext(context.Background(), keywords, f)
+}
+
+// SearchContext is like Search but uses ctx for the request.
+func (c *Client) SearchContext(ctx context.Context, keywords string, f func(SearchResult) error) error {
+	return c.getCSV(ctx, "/query", url.Values{
 		"function": []string{"SYMBOL_SEARCH"},
 		"keywords": []string{keywords},
 	}, func(header, record []string) error {
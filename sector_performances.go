@@ -15,6 +15,7 @@
 package alphavantage
 
 import (
+	"context"
 	"net/url"
 
 	"github.com/tradyfinance/marshaler"
@@ -58,7 +59,13 @@ type SectorPerformances struct {
 // periods of time.
 //
 // See: https://www.alphavantage.co/documentation/#sector-information
-func (c *Client) GetSectorPerformances() (sp SectorPerformances, err error) {
-	err = c.getJSON("/query", url.Values{"function": []string{"SECTOR"}}, &sp)
+func (c *Client) GetSectorPerformances() (SectorPerformances, error) {
+	return c.GetSectorPerformancesContext(context.Background())
+}
+
+// GetSectorPerformancesContext is like GetSectorPerformances but uses ctx for
+// the request.
+func (c *Client) GetSectorPerformancesContext(ctx context.Context) (sp SectorPerformances, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{"function": []string{"SECTOR"}}, &sp)
 	return
 }
@@ -15,6 +15,8 @@
 package alphavantage
 
 import (
+	"context"
+	"errors"
 	"net/url"
 
 	"github.com/tradyfinance/csvext"
@@ -52,6 +54,12 @@ type StockQuoteAdjusted struct {
 //
 // See: https://www.alphavantage.co/documentation/#time-series-data
 func (c *Client) GetStockTimeSeries(symbol string, interval Interval, outputSize OutputSize, f func(StockQuote) error) error {
+	return c.GetStockTimeSeriesContext(context.Background(), symbol, interval, outputSize, f)
+}
+
+// GetStockTimeSeriesContext is like GetStockTimeSeries but uses ctx for the
+// request.
+func (c *Client) GetStockTimeSeriesContext(ctx context.Context, symbol string, interval Interval, outputSize OutputSize, f func(StockQuote) error) error {
 	query := url.Values{
 		"symbol":     []string{symbol},
 		"outputsize": []string{string(outputSize)},
@@ -74,8 +82,12 @@ func (c *Client) GetStockTimeSeries(symbol string, interval Interval, outputSize
 		fallthrough
 	case Interval1Month:
 		query.Set("function", "TIME_SERIES_"+string(interval))
+	case Interval1Quarter:
+		fallthrough
+	case Interval1Year:
+		return errors.New("only intraday, daily, weekly, and monthly intervals are supported for stocks on Alpha Vantage")
 	}
-	return c.getCSV("/query", query, func(header, record []string) error {
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
 		var q StockQuote
 		if err := csvext.UnmarshalRecord(header, record, &q); err != nil {
 			return err
@@ -89,6 +101,12 @@ func (c *Client) GetStockTimeSeries(symbol string, interval Interval, outputSize
 //
 // See: https://www.alphavantage.co/documentation/#time-series-data
 func (c *Client) GetStockTimeSeriesAdjusted(symbol string, interval Interval, outputSize OutputSize, f func(StockQuoteAdjusted) error) error {
+	return c.GetStockTimeSeriesAdjustedContext(context.Background(), symbol, interval, outputSize, f)
+}
+
+// GetStockTimeSeriesAdjustedContext is like GetStockTimeSeriesAdjusted but
+// uses ctx for the request.
+func (c *Client) GetStockTimeSeriesAdjustedContext(ctx context.Context, symbol string, interval Interval, outputSize OutputSize, f func(StockQuoteAdjusted) error) error {
 	query := url.Values{
 		"symbol":     []string{symbol},
 		"outputsize": []string{string(outputSize)},
@@ -111,8 +129,12 @@ func (c *Client) GetStockTimeSeriesAdjusted(symbol string, interval Interval, ou
 		fallthrough
 	case Interval1Month:
 		query.Set("function", "TIME_SERIES_"+string(interval)+"_ADJUSTED")
+	case Interval1Quarter:
+		fallthrough
+	case Interval1Year:
+		return errors.New("only intraday, daily, weekly, and monthly intervals are supported for stocks on Alpha Vantage")
 	}
-	return c.getCSV("/query", query, func(header, record []string) error {
+	return c.getCSV(ctx, "/query", query, func(header, record []string) error {
 		var q StockQuoteAdjusted
 		if err := csvext.UnmarshalRecord(header, record, &q); err != nil {
 			return err
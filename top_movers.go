@@ -0,0 +1,81 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/tradyfinance/marshaler"
+)
+
+// A Mover is a ticker among the top gainers, top losers or most actively
+// traded.
+//
+// See: https://www.alphavantage.co/documentation/#gainer-loser
+type Mover struct {
+	Ticker           string              `json:"ticker"`
+	Price            float64             `json:"price,string"`
+	ChangeAmount     float64             `json:"change_amount,string"`
+	ChangePercentage marshaler.Percent64 `json:"change_percentage"`
+	Volume           int64               `json:"volume,string"`
+}
+
+// TopMovers are the top gainers, top losers and most actively traded US
+// tickers.
+//
+// See: https://www.alphavantage.co/documentation/#gainer-loser
+type TopMovers struct {
+	LastUpdated        time.Time // Zero if malformed or its zone is unavailable.
+	TopGainers         []Mover
+	TopLosers          []Mover
+	MostActivelyTraded []Mover
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (tm *TopMovers) UnmarshalJSON(b []byte) error {
+	var v struct {
+		LastUpdated        string  `json:"last_updated"`
+		TopGainers         []Mover `json:"top_gainers"`
+		TopLosers          []Mover `json:"top_losers"`
+		MostActivelyTraded []Mover `json:"most_actively_traded"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	// A malformed time is left zero rather than discarding the movers.
+	tm.LastUpdated, _ = parseZonedTime(v.LastUpdated)
+	tm.TopGainers = v.TopGainers
+	tm.TopLosers = v.TopLosers
+	tm.MostActivelyTraded = v.MostActivelyTraded
+	return nil
+}
+
+// GetTopMovers returns the top gainers, top losers and most actively traded US
+// tickers.
+//
+// See: https://www.alphavantage.co/documentation/#gainer-loser
+func (c *Client) GetTopMovers() (TopMovers, error) {
+	return c.GetTopMoversContext(context.Background())
+}
+
+// GetTopMoversContext is like GetTopMovers but uses ctx for the request.
+func (c *Client) GetTopMoversContext(ctx context.Context) (tm TopMovers, err error) {
+	err = c.getJSON(ctx, "/query", url.Values{"function": []string{"TOP_GAINERS_LOSERS"}}, &tm)
+	return
+}
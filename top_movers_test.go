@@ -0,0 +1,106 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+)
+
+func TestClient_GetTopMovers(t *testing.T) {
+	loc, err := time.LoadLocation("US/Eastern")
+	if err != nil {
+		t.Skip(err)
+	}
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"metadata": "Top gainers, losers, and most actively traded US tickers",
+			"last_updated": "2023-10-27 16:15:58 US/Eastern",
+			"top_gainers": [
+				{"ticker": "ABC", "price": "0.0325", "change_amount": "0.0196", "change_percentage": "151.938%", "volume": "1234560"}
+			],
+			"top_losers": [
+				{"ticker": "DEF", "price": "1.23", "change_amount": "-2.77", "change_percentage": "-69.25%", "volume": "98765"}
+			],
+			"most_actively_traded": []
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetTopMovers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2023, 10, 27, 16, 15, 58, 0, loc); !got.LastUpdated.Equal(want) {
+		t.Fatalf("got last updated %v, want %v", got.LastUpdated, want)
+	}
+	if want := []Mover{{"ABC", 0.0325, 0.0196, 1.51938, 1234560}}; !reflect.DeepEqual(got.TopGainers, want) {
+		t.Fatalf("got top gainers %+v, want %+v", got.TopGainers, want)
+	}
+	if want := []Mover{{"DEF", 1.23, -2.77, -0.6925, 98765}}; !reflect.DeepEqual(got.TopLosers, want) {
+		t.Fatalf("got top losers %+v, want %+v", got.TopLosers, want)
+	}
+	if len(got.MostActivelyTraded) != 0 {
+		t.Fatalf("got most actively traded %+v, want none", got.MostActivelyTraded)
+	}
+}
+
+func TestClient_GetTopMovers_unknownZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(`{
+			"last_updated": "2023-10-27 16:15:58 Mars/Olympus_Mons",
+			"top_gainers": [
+				{"ticker": "ABC", "price": "0.0325", "change_amount": "0.0196", "change_percentage": "151.938%", "volume": "1234560"}
+			],
+			"top_losers": [],
+			"most_actively_traded": []
+		}`))
+		return &res, nil
+	}), "")
+	got, err := c.GetTopMovers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2023, 10, 27, 16, 15, 58, 0, loc); !got.LastUpdated.Equal(want) {
+		t.Fatalf("got last updated %v, want %v", got.LastUpdated, want)
+	}
+	if len(got.TopGainers) != 1 {
+		t.Fatalf("got top gainers %+v, want 1", got.TopGainers)
+	}
+}
+
+func TestParseZonedTime_noTimeZoneDatabase(t *testing.T) {
+	defer func(loc *time.Location) { marketLocation = loc }(marketLocation)
+	marketLocation = nil
+	if _, err := parseZonedTime("2023-10-27 16:15:58 Mars/Olympus_Mons"); err == nil {
+		t.Fatal("got no error without a time zone")
+	}
+	if got, err := parseZonedTime("2023-10-27 16:15:58"); err != nil || !got.Equal(time.Date(2023, 10, 27, 16, 15, 58, 0, time.UTC)) {
+		t.Fatalf("got %v, %v", got, err)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tradyfinance/csvext"
+	"github.com/tradyfinance/marshaler"
+)
+
+// A BBandsPoint is a data point of the Bollinger bands (BBANDS).
+//
+// See: https://www.alphavantage.co/documentation/#technical-indicators
+type BBandsPoint struct {
+	Timestamp  marshaler.FlexibleTime `csv:"time"`
+	UpperBand  float64                `csv:"Real Upper Band"`
+	MiddleBand float64                `csv:"Real Middle Band"`
+	LowerBand  float64                `csv:"Real Lower Band"`
+}
+
+// A BBandsRequest is a request for Bollinger bands (BBANDS) data. Optional
+// parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#bbands
+type BBandsRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+	NBDevUp    int    // Optional standard deviation multiplier of the upper band.
+	NBDevDn    int    // Optional standard deviation multiplier of the lower band.
+	MAType     MAType // Optional moving average type.
+}
+
+func (r BBandsRequest) query() url.Values {
+	query := indicatorQuery("BBANDS", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	setInt(query, "nbdevup", r.NBDevUp)
+	setInt(query, "nbdevdn", r.NBDevDn)
+	setInt(query, "matype", int(r.MAType))
+	return query
+}
+
+// GetBBands gets Bollinger bands (BBANDS) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#bbands
+func (c *Client) GetBBands(req BBandsRequest, f func(BBandsPoint) error) error {
+	return c.GetBBandsContext(context.Background(), req, f)
+}
+
+// GetBBandsContext is like GetBBands but uses ctx for the request.
+func (c *Client) GetBBandsContext(ctx context.Context, req BBandsRequest, f func(BBandsPoint) error) error {
+	return c.getCSV(ctx, "/query", req.query(), func(header, record []string) error {
+		var p BBandsPoint
+		if err := csvext.UnmarshalRecord(header, record, &p); err != nil {
+			return err
+		}
+		return f(p)
+	})
+}
+
+// A MidPointRequest is a request for midpoint (MIDPOINT) data.
+//
+// See: https://www.alphavantage.co/documentation/#midpoint
+type MidPointRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+	SeriesType SeriesType
+}
+
+func (r MidPointRequest) query() url.Values {
+	query := indicatorQuery("MIDPOINT", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	setString(query, "series_type", string(r.SeriesType))
+	return query
+}
+
+// GetMidPoint gets midpoint (MIDPOINT) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#midpoint
+func (c *Client) GetMidPoint(req MidPointRequest, f func(IndicatorPoint) error) error {
+	return c.GetMidPointContext(context.Background(), req, f)
+}
+
+// GetMidPointContext is like GetMidPoint but uses ctx for the request.
+func (c *Client) GetMidPointContext(ctx context.Context, req MidPointRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A MidPriceRequest is a request for midpoint price (MIDPRICE) data.
+//
+// See: https://www.alphavantage.co/documentation/#midprice
+type MidPriceRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r MidPriceRequest) query() url.Values {
+	query := indicatorQuery("MIDPRICE", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetMidPrice gets midpoint price (MIDPRICE) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#midprice
+func (c *Client) GetMidPrice(req MidPriceRequest, f func(IndicatorPoint) error) error {
+	return c.GetMidPriceContext(context.Background(), req, f)
+}
+
+// GetMidPriceContext is like GetMidPrice but uses ctx for the request.
+func (c *Client) GetMidPriceContext(ctx context.Context, req MidPriceRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An SARRequest is a request for parabolic stop and reverse (SAR) data.
+// Optional parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#sar
+type SARRequest struct {
+	Symbol       string
+	Interval     Interval
+	Acceleration float64 // Optional acceleration factor.
+	Maximum      float64 // Optional acceleration factor upper limit.
+}
+
+func (r SARRequest) query() url.Values {
+	query := indicatorQuery("SAR", r.Symbol, r.Interval)
+	setFloat(query, "acceleration", r.Acceleration)
+	setFloat(query, "maximum", r.Maximum)
+	return query
+}
+
+// GetSAR gets parabolic stop and reverse (SAR) data, calling f for each data
+// point.
+//
+// See: https://www.alphavantage.co/documentation/#sar
+func (c *Client) GetSAR(req SARRequest, f func(IndicatorPoint) error) error {
+	return c.GetSARContext(context.Background(), req, f)
+}
+
+// GetSARContext is like GetSAR but uses ctx for the request.
+func (c *Client) GetSARContext(ctx context.Context, req SARRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// A TRangeRequest is a request for true range (TRANGE) data.
+//
+// See: https://www.alphavantage.co/documentation/#trange
+type TRangeRequest struct {
+	Symbol   string
+	Interval Interval
+}
+
+func (r TRangeRequest) query() url.Values {
+	query := indicatorQuery("TRANGE", r.Symbol, r.Interval)
+	return query
+}
+
+// GetTRange gets true range (TRANGE) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#trange
+func (c *Client) GetTRange(req TRangeRequest, f func(IndicatorPoint) error) error {
+	return c.GetTRangeContext(context.Background(), req, f)
+}
+
+// GetTRangeContext is like GetTRange but uses ctx for the request.
+func (c *Client) GetTRangeContext(ctx context.Context, req TRangeRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An ATRRequest is a request for average true range (ATR) data.
+//
+// See: https://www.alphavantage.co/documentation/#atr
+type ATRRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r ATRRequest) query() url.Values {
+	query := indicatorQuery("ATR", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetATR gets average true range (ATR) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#atr
+func (c *Client) GetATR(req ATRRequest, f func(IndicatorPoint) error) error {
+	return c.GetATRContext(context.Background(), req, f)
+}
+
+// GetATRContext is like GetATR but uses ctx for the request.
+func (c *Client) GetATRContext(ctx context.Context, req ATRRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An NATRRequest is a request for normalized average true range (NATR) data.
+//
+// See: https://www.alphavantage.co/documentation/#natr
+type NATRRequest struct {
+	Symbol     string
+	Interval   Interval
+	TimePeriod int // Number of data points per value.
+}
+
+func (r NATRRequest) query() url.Values {
+	query := indicatorQuery("NATR", r.Symbol, r.Interval)
+	setInt(query, "time_period", r.TimePeriod)
+	return query
+}
+
+// GetNATR gets normalized average true range (NATR) data, calling f for each
+// data point.
+//
+// See: https://www.alphavantage.co/documentation/#natr
+func (c *Client) GetNATR(req NATRRequest, f func(IndicatorPoint) error) error {
+	return c.GetNATRContext(context.Background(), req, f)
+}
+
+// GetNATRContext is like GetNATR but uses ctx for the request.
+func (c *Client) GetNATRContext(ctx context.Context, req NATRRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
@@ -0,0 +1,95 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetBBands(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader(
+			"time,Real Lower Band,Real Upper Band,Real Middle Band\n" +
+				"2019-09-17,132.6021,140.1939,136.3980\n",
+		))
+		return &res, nil
+	}), "")
+	got := []BBandsPoint{}
+	if err := c.GetBBands(BBandsRequest{
+		Symbol:     "MSFT",
+		Interval:   Interval1Day,
+		TimePeriod: 20,
+		SeriesType: SeriesTypeClose,
+		NBDevUp:    2,
+		NBDevDn:    2,
+	}, func(p BBandsPoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&function=BBANDS&interval=daily&nbdevdn=2&nbdevup=2&series_type=close&symbol=MSFT&time_period=20"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []BBandsPoint{
+		BBandsPoint{
+			Timestamp:  marshaler.FlexibleTime(time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)),
+			UpperBand:  140.1939,
+			MiddleBand: 136.3980,
+			LowerBand:  132.6021,
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_GetSAR(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("time,SAR\n2019-09-17,134.8113\n"))
+		return &res, nil
+	}), "")
+	if err := c.GetSAR(SARRequest{
+		Symbol:       "MSFT",
+		Interval:     Interval1Week,
+		Acceleration: 0.05,
+		Maximum:      0.25,
+	}, func(p IndicatorPoint) error {
+		if p.Value() != 134.8113 {
+			t.Fatalf("got value %v, want %v", p.Value(), 134.8113)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "acceleration=0.05&datatype=csv&function=SAR&interval=weekly&maximum=0.25&symbol=MSFT"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+}
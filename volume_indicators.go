@@ -0,0 +1,103 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+)
+
+// An ADRequest is a request for Chaikin accumulation/distribution line (AD)
+// data.
+//
+// See: https://www.alphavantage.co/documentation/#ad
+type ADRequest struct {
+	Symbol   string
+	Interval Interval
+}
+
+func (r ADRequest) query() url.Values {
+	query := indicatorQuery("AD", r.Symbol, r.Interval)
+	return query
+}
+
+// GetAD gets Chaikin accumulation/distribution line (AD) data, calling f for
+// each data point.
+//
+// See: https://www.alphavantage.co/documentation/#ad
+func (c *Client) GetAD(req ADRequest, f func(IndicatorPoint) error) error {
+	return c.GetADContext(context.Background(), req, f)
+}
+
+// GetADContext is like GetAD but uses ctx for the request.
+func (c *Client) GetADContext(ctx context.Context, req ADRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An ADOscRequest is a request for Chaikin accumulation/distribution oscillator
+// (ADOSC) data. Optional parameters left zero use the Alpha Vantage defaults.
+//
+// See: https://www.alphavantage.co/documentation/#adosc
+type ADOscRequest struct {
+	Symbol     string
+	Interval   Interval
+	FastPeriod int // Optional fast time period.
+	SlowPeriod int // Optional slow time period.
+}
+
+func (r ADOscRequest) query() url.Values {
+	query := indicatorQuery("ADOSC", r.Symbol, r.Interval)
+	setInt(query, "fastperiod", r.FastPeriod)
+	setInt(query, "slowperiod", r.SlowPeriod)
+	return query
+}
+
+// GetADOsc gets Chaikin accumulation/distribution oscillator (ADOSC) data,
+// calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#adosc
+func (c *Client) GetADOsc(req ADOscRequest, f func(IndicatorPoint) error) error {
+	return c.GetADOscContext(context.Background(), req, f)
+}
+
+// GetADOscContext is like GetADOsc but uses ctx for the request.
+func (c *Client) GetADOscContext(ctx context.Context, req ADOscRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
+
+// An OBVRequest is a request for on balance volume (OBV) data.
+//
+// See: https://www.alphavantage.co/documentation/#obv
+type OBVRequest struct {
+	Symbol   string
+	Interval Interval
+}
+
+func (r OBVRequest) query() url.Values {
+	query := indicatorQuery("OBV", r.Symbol, r.Interval)
+	return query
+}
+
+// GetOBV gets on balance volume (OBV) data, calling f for each data point.
+//
+// See: https://www.alphavantage.co/documentation/#obv
+func (c *Client) GetOBV(req OBVRequest, f func(IndicatorPoint) error) error {
+	return c.GetOBVContext(context.Background(), req, f)
+}
+
+// GetOBVContext is like GetOBV but uses ctx for the request.
+func (c *Client) GetOBVContext(ctx context.Context, req OBVRequest, f func(IndicatorPoint) error) error {
+	return c.getIndicator(ctx, req.query(), f)
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradyfinance/httpext"
+	"github.com/tradyfinance/marshaler"
+)
+
+func TestClient_GetADOsc(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("time,ADOSC\n2019-09-17,3215617.2043\n"))
+		return &res, nil
+	}), "")
+	got := []IndicatorPoint{}
+	if err := c.GetADOsc(ADOscRequest{
+		Symbol:     "MSFT",
+		Interval:   Interval1Day,
+		FastPeriod: 5,
+		SlowPeriod: 12,
+	}, func(p IndicatorPoint) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&fastperiod=5&function=ADOSC&interval=daily&slowperiod=12&symbol=MSFT"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+	if want := []IndicatorPoint{
+		IndicatorPoint{
+			Timestamp: marshaler.FlexibleTime(time.Date(2019, 9, 17, 0, 0, 0, 0, time.UTC)),
+			Values:    map[string]float64{"ADOSC": 3215617.2043},
+		},
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_GetAD(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("time,Chaikin A/D\n2019-09-17,165094383.3521\n"))
+		return &res, nil
+	}), "")
+	if err := c.GetAD(ADRequest{Symbol: "MSFT", Interval: Interval1Day}, func(p IndicatorPoint) error {
+		if p.Value() != 165094383.3521 {
+			t.Fatalf("got value %v, want %v", p.Value(), 165094383.3521)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&function=AD&interval=daily&symbol=MSFT"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+}
+
+func TestClient_GetOBV(t *testing.T) {
+	var query string
+	c := NewClient(httpext.WithTransportFunc(nil, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		var res http.Response
+		res.StatusCode = http.StatusOK
+		res.Body = ioutil.NopCloser(strings.NewReader("time,OBV\n2019-09-17,412398723.0000\n"))
+		return &res, nil
+	}), "")
+	if err := c.GetOBV(OBVRequest{Symbol: "MSFT", Interval: Interval1Week}, func(p IndicatorPoint) error {
+		if p.Value() != 412398723 {
+			t.Fatalf("got value %v, want %v", p.Value(), 412398723.0)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "datatype=csv&function=OBV&interval=weekly&symbol=MSFT"; query != want {
+		t.Fatalf("got query %q, want %q", query, want)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2019 Miles Barr <milesbarr2@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alphavantage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timeNow returns the current time. Tests replace it to pin the time.
+var timeNow = time.Now
+
+// marketLocation is the time zone of the US markets, in which Alpha Vantage
+// reports most times. It is nil if the host has no time zone database.
+var marketLocation = loadLocation("America/New_York")
+
+// loadLocation returns the time zone with the given name, or nil if it is
+// unknown or the host has no time zone database. Programs may embed one by
+// importing time/tzdata.
+func loadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
+// parseZonedTime parses a time optionally followed by the name of its time
+// zone, e.g. "2023-10-27 16:15:58 US/Eastern". Times in unknown time zones are
+// taken to be in the time zone of the US markets, and an error is returned if
+// no time zone can be loaded.
+func parseZonedTime(s string) (time.Time, error) {
+	loc := time.UTC
+	if fields := strings.Fields(s); len(fields) == 3 {
+		loc = loadLocation(fields[2])
+		if loc == nil {
+			loc = marketLocation
+		}
+		if loc == nil {
+			return time.Time{}, fmt.Errorf("alphavantage: unknown time zone %q", fields[2])
+		}
+		s = fields[0] + " " + fields[1]
+	}
+	return time.ParseInLocation("2006-01-02 15:04:05", s, loc)
+}